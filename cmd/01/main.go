@@ -10,6 +10,7 @@ import (
 	"unicode"
 
 	"github.com/Xjs/aoc2021/part"
+	"github.com/Xjs/aoc2023/parse"
 )
 
 func part1(r io.Reader) (int, error) {
@@ -40,44 +41,17 @@ func part1(r io.Reader) (int, error) {
 	return sum, nil
 }
 
-var numbers = map[string]int{
-	"one":   1,
-	"two":   2,
-	"three": 3,
-	"four":  4,
-	"five":  5,
-	"six":   6,
-	"seven": 7,
-	"eight": 8,
-	"nine":  9,
-}
-
-func digitAt(s string, i int) int {
-	if unicode.IsDigit(rune(s[i])) {
-		return int(s[i] - '0')
-	}
-	for k, n := range numbers {
-		if len(s) < i+len(k) {
-			continue
-		}
-		if s[i:i+len(k)] == k {
-			return n
-		}
-	}
-
-	return -1
-}
+var digits = parse.NewTokenizer(parse.Digits, parse.DigitWords)
 
 func part2(r io.Reader) (int, error) {
 	sum := 0
 
 	s := bufio.NewScanner(r)
 	for s.Scan() {
-		line := s.Text()
 		first := 0
 		last := 0
-		for i := 0; i < len(line); i++ {
-			d := digitAt(line, i)
+		for _, t := range digits.FindAll(s.Text()) {
+			d := t.Value
 			if d > 0 {
 				if first == 0 {
 					first = 10 * d
@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/Xjs/aoc2021/part"
+	"github.com/Xjs/aoc2023/parse"
 )
 
 type Sample struct {
@@ -21,7 +22,7 @@ type Game struct {
 	Samples []Sample
 }
 
-func parse(r io.Reader) ([]Game, error) {
+func parseAll(r io.Reader) ([]Game, error) {
 	var games []Game
 
 	s := bufio.NewScanner(r)
@@ -29,7 +30,7 @@ func parse(r io.Reader) ([]Game, error) {
 		line := s.Text()
 		game, err := parseGame(line)
 		if err != nil {
-			return nil, err
+			return nil, parse.AtLine(err, len(games)+1)
 		}
 		games = append(games, game)
 	}
@@ -41,27 +42,19 @@ func mustParseGame(line string) Game {
 	return g
 }
 
-func parseGame(line string) (Game, error) {
-	splices := strings.Split(line, ":")
-	if len(splices) != 2 {
-		return Game{}, fmt.Errorf("invalid number of colons in line %q", line)
-	}
-	gameID, rest := splices[0], splices[1]
+var gamePattern = parse.MustCompilePattern("Game {id:int}: {rest}")
 
-	const gamePrefix = "Game "
-	if !strings.HasPrefix(gameID, gamePrefix) {
-		return Game{}, fmt.Errorf("line %q doesn't start with %q", line, gamePrefix)
+func parseGame(line string) (Game, error) {
+	var header struct {
+		ID   int
+		Rest string
 	}
-	gameID = strings.TrimPrefix(gameID, gamePrefix)
-
-	var game Game
-	var err error
-	game.ID, err = strconv.Atoi(gameID)
-	if err != nil {
-		return Game{}, fmt.Errorf("line %q: game ID: %w", line, err)
+	if err := gamePattern.Fill(line, &header); err != nil {
+		return Game{}, err
 	}
+	game := Game{ID: header.ID}
 
-	for _, splice := range strings.Split(rest, ";") {
+	for _, splice := range strings.Split(header.Rest, ";") {
 		var sample Sample
 		for _, colour := range strings.Split(splice, ",") {
 			colour = strings.TrimSpace(colour)
@@ -125,7 +118,7 @@ func sumPossible(g []Game) int {
 }
 
 func part1(r io.Reader) (int, error) {
-	games, err := parse(r)
+	games, err := parseAll(r)
 	if err != nil {
 		return 0, err
 	}
@@ -153,7 +146,7 @@ func power(s Sample) int {
 }
 
 func part2(r io.Reader) (int, error) {
-	games, err := parse(r)
+	games, err := parseAll(r)
 	if err != nil {
 		return 0, err
 	}
@@ -9,6 +9,7 @@ import (
 	"unicode"
 
 	"github.com/Xjs/aoc2023/grid"
+	"github.com/Xjs/aoc2023/set"
 )
 
 func part1and2(r io.Reader) (int, int, error) {
@@ -71,7 +72,7 @@ func part1and2(r io.Reader) (int, int, error) {
 		}
 	})
 
-	gearMap := make(map[grid.Point]map[id]struct{})
+	gearMap := make(map[grid.Point]set.Set[id])
 
 	sum := 0
 	for theID, syms := range haveAdjacentSymbol {
@@ -83,9 +84,9 @@ func part1and2(r io.Reader) (int, int, error) {
 		for _, sym := range syms {
 			if g.MustAt(sym) == '*' {
 				if gearMap[sym] == nil {
-					gearMap[sym] = make(map[id]struct{})
+					gearMap[sym] = set.Of[id]()
 				}
-				gearMap[sym][theID] = struct{}{}
+				gearMap[sym].Add(theID)
 			}
 		}
 	}
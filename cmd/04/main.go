@@ -2,70 +2,41 @@ package main
 
 import (
 	"bufio"
-	"fmt"
 	"io"
 	"log"
 	"math"
 	"os"
-	"strconv"
-	"strings"
 
 	"github.com/Xjs/aoc2023/parse"
+	"github.com/Xjs/aoc2023/set"
 )
 
 type Card struct {
 	ID      int
-	Winning map[int]struct{}
-	Have    map[int]struct{}
+	Winning set.Set[int]
+	Have    set.Set[int]
 
 	Copies int
 }
 
-func ParseCard(l string) (Card, error) {
-	const prefix = "Card "
-	if !strings.HasPrefix(l, prefix) {
-		return Card{}, fmt.Errorf("line %q doesn't start with %q", l, prefix)
-	}
-	l = strings.TrimPrefix(l, prefix)
-
-	parts := strings.Split(l, ":")
-	if len(parts) != 2 {
-		return Card{}, fmt.Errorf("line %q doesn't contain exactly one colon", l)
-	}
+var cardPattern = parse.MustCompilePattern("Card {id:int}: {win:ints} | {have:ints}")
 
-	id, err := strconv.Atoi(strings.TrimSpace(parts[0]))
-	if err != nil {
-		return Card{}, fmt.Errorf("line %q: error parsing ID: %w", l, err)
-	}
-
-	card := Card{ID: id, Copies: 1}
-
-	parts = strings.Split(parts[1], "|")
-	if len(parts) != 2 {
-		return card, fmt.Errorf("line %q: must contain exactly one pipe", l)
-	}
-
-	winning, err := parse.IntListWhitespace(parts[0])
-	if err != nil {
-		return card, fmt.Errorf("part %q: error parsing integer list: %w", parts[0], err)
-	}
-
-	card.Winning = make(map[int]struct{})
-	for _, num := range winning {
-		card.Winning[num] = struct{}{}
-	}
-
-	have, err := parse.IntListWhitespace(parts[1])
-	if err != nil {
-		return card, fmt.Errorf("part %q: error parsing integer list: %w", parts[1], err)
+func ParseCard(l string) (Card, error) {
+	var raw struct {
+		ID      int
+		Winning []int `parse:"win"`
+		Have    []int
 	}
-
-	card.Have = make(map[int]struct{})
-	for _, num := range have {
-		card.Have[num] = struct{}{}
+	if err := cardPattern.Fill(l, &raw); err != nil {
+		return Card{}, err
 	}
 
-	return card, nil
+	return Card{
+		ID:      raw.ID,
+		Winning: set.Of(raw.Winning...),
+		Have:    set.Of(raw.Have...),
+		Copies:  1,
+	}, nil
 }
 
 func (c Card) Value() int {
@@ -73,16 +44,7 @@ func (c Card) Value() int {
 }
 
 func (c Card) Matching() int {
-	matching := 0
-
-	for num := range c.Winning {
-		_, have := c.Have[num]
-		if !have {
-			continue
-		}
-		matching++
-	}
-	return matching
+	return len(c.Winning.Intersect(c.Have))
 }
 
 func parseAll(r io.Reader) ([]*Card, error) {
@@ -91,7 +53,7 @@ func parseAll(r io.Reader) ([]*Card, error) {
 	for s.Scan() {
 		card, err := ParseCard(s.Text())
 		if err != nil {
-			return cards, err
+			return nil, parse.AtLine(err, len(cards)+1)
 		}
 		cards = append(cards, &card)
 	}
@@ -0,0 +1,125 @@
+// Package counter provides a multiset for frequency analysis.
+package counter
+
+import (
+	"slices"
+)
+
+// A Counter counts occurrences of keys. The zero value is an empty Counter.
+// A Counter must not be copied after first use; pass *Counter around instead.
+type Counter[K comparable] struct {
+	counts map[K]int
+	// order holds the keys in order of their first occurrence, which is used
+	// to break ties deterministically.
+	order []K
+}
+
+// Of returns a Counter with the occurrences of keys.
+func Of[K comparable](keys ...K) *Counter[K] {
+	c := new(Counter[K])
+	for _, k := range keys {
+		c.Add(k)
+	}
+	return c
+}
+
+// Add counts one occurrence of k.
+func (c *Counter[K]) Add(k K) {
+	c.AddN(k, 1)
+}
+
+// AddN counts n occurrences of k. A negative n removes occurrences; k is
+// removed entirely once its count drops to zero or below, so it counts as
+// a new key if it is added again later.
+func (c *Counter[K]) AddN(k K, n int) {
+	if n == 0 {
+		return
+	}
+	if c.counts == nil {
+		c.counts = make(map[K]int)
+	}
+	old, ok := c.counts[k]
+	switch {
+	case old+n <= 0:
+		if ok {
+			delete(c.counts, k)
+			c.order = slices.DeleteFunc(c.order, func(o K) bool { return o == k })
+		}
+		return
+	case !ok:
+		c.order = append(c.order, k)
+	}
+	c.counts[k] = old + n
+}
+
+// Count returns the number of occurrences of k.
+func (c *Counter[K]) Count(k K) int {
+	return c.counts[k]
+}
+
+// Len returns the number of distinct keys.
+func (c *Counter[K]) Len() int {
+	return len(c.counts)
+}
+
+// Total returns the number of occurrences of all keys.
+func (c *Counter[K]) Total() int {
+	total := 0
+	for _, n := range c.counts {
+		total += n
+	}
+	return total
+}
+
+// An Item is a key together with its count.
+type Item[K comparable] struct {
+	Key   K
+	Count int
+}
+
+// Items returns all keys with their counts, ordered by descending count.
+// Keys with equal counts are ordered by their first occurrence.
+func (c *Counter[K]) Items() []Item[K] {
+	items := make([]Item[K], len(c.order))
+	for i, k := range c.order {
+		items[i] = Item[K]{Key: k, Count: c.counts[k]}
+	}
+	slices.SortStableFunc(items, func(a, b Item[K]) int {
+		return b.Count - a.Count
+	})
+	return items
+}
+
+// MostCommon returns the n most common items, ordered as by Items.
+// If n is negative or exceeds the number of keys, all items are returned.
+func (c *Counter[K]) MostCommon(n int) []Item[K] {
+	items := c.Items()
+	if n < 0 || n > len(items) {
+		return items
+	}
+	return items[:n]
+}
+
+// Shape returns the counts in descending order, which is a canonical
+// signature of the distribution of keys, e. g. [3 2] for a full house.
+func (c *Counter[K]) Shape() []int {
+	shape := make([]int, 0, len(c.counts))
+	for _, n := range c.counts {
+		shape = append(shape, n)
+	}
+	slices.Sort(shape)
+	slices.Reverse(shape)
+	return shape
+}
+
+// Intersect returns the keys occurring in both c and o, each counted as often
+// as it occurs in both.
+func (c *Counter[K]) Intersect(o *Counter[K]) *Counter[K] {
+	result := new(Counter[K])
+	for _, k := range c.order {
+		if n := min(c.counts[k], o.counts[k]); n > 0 {
+			result.AddN(k, n)
+		}
+	}
+	return result
+}
@@ -0,0 +1,107 @@
+package counter
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestCounter(t *testing.T) {
+	c := Of([]rune("KTJJT")...)
+
+	if got := c.Count('J'); got != 2 {
+		t.Errorf("Count('J') = %d, want 2", got)
+	}
+	if got := c.Count('A'); got != 0 {
+		t.Errorf("Count('A') = %d, want 0", got)
+	}
+	if c.Len() != 3 || c.Total() != 5 {
+		t.Errorf("Len(), Total() = %d, %d, want 3, 5", c.Len(), c.Total())
+	}
+
+	want := []Item[rune]{{'T', 2}, {'J', 2}, {'K', 1}}
+	if got := c.Items(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Items() = %v, want %v", got, want)
+	}
+	if got := c.MostCommon(1); !reflect.DeepEqual(got, want[:1]) {
+		t.Errorf("MostCommon(1) = %v, want %v", got, want[:1])
+	}
+	if got := c.MostCommon(10); !reflect.DeepEqual(got, want) {
+		t.Errorf("MostCommon(10) = %v, want %v", got, want)
+	}
+}
+
+func TestCounter_Shape(t *testing.T) {
+	tests := []struct {
+		hand string
+		want []int
+	}{
+		{"AAAAA", []int{5}},
+		{"AA8AA", []int{4, 1}},
+		{"23332", []int{3, 2}},
+		{"TTT98", []int{3, 1, 1}},
+		{"23432", []int{2, 2, 1}},
+		{"A23A4", []int{2, 1, 1, 1}},
+		{"23456", []int{1, 1, 1, 1, 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.hand, func(t *testing.T) {
+			if got := Of([]rune(tt.hand)...).Shape(); !slices.Equal(got, tt.want) {
+				t.Errorf("Shape() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCounter_Intersect(t *testing.T) {
+	var zero Counter[int]
+	if got := zero.Intersect(Of(1, 2)).Len(); got != 0 {
+		t.Errorf("zero.Intersect().Len() = %d, want 0", got)
+	}
+
+	got := Of(1, 1, 2, 3, 3, 3).Intersect(Of(3, 1, 3, 4))
+	want := []Item[int]{{1, 1}, {3, 2}}
+	if !reflect.DeepEqual(got.Items(), []Item[int]{want[1], want[0]}) {
+		t.Errorf("Intersect().Items() = %v, want %v", got.Items(), want)
+	}
+	if got.Total() != 3 {
+		t.Errorf("Intersect().Total() = %d, want 3", got.Total())
+	}
+}
+
+func TestCounter_AddN(t *testing.T) {
+	c := Of(1, 2, 2, 3)
+	c.AddN(4, 0)
+	if got := c.Len(); got != 3 {
+		t.Errorf("Len() after AddN(4, 0) = %d, want 3", got)
+	}
+
+	c.AddN(2, -1)
+	c.AddN(1, -5)
+	c.AddN(5, -1)
+	want := []Item[int]{{2, 1}, {3, 1}}
+	if got := c.Items(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Items() = %v, want %v", got, want)
+	}
+	if c.Len() != 2 || c.Total() != 2 {
+		t.Errorf("Len(), Total() = %d, %d, want 2, 2", c.Len(), c.Total())
+	}
+	if got := c.Shape(); !slices.Equal(got, []int{1, 1}) {
+		t.Errorf("Shape() = %v, want [1 1]", got)
+	}
+
+	c.Add(1)
+	want = append(want, Item[int]{1, 1})
+	if got := c.Items(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Items() after re-adding 1 = %v, want %v", got, want)
+	}
+}
+
+func TestCounter_Shared(t *testing.T) {
+	c := Of(1, 2)
+	c2 := c
+	c2.Add(3)
+	if c.Len() != 3 || len(c.Items()) != 3 {
+		t.Errorf("Len(), len(Items()) = %d, %d, want 3, 3", c.Len(), len(c.Items()))
+	}
+}
@@ -0,0 +1,111 @@
+// Package cycle detects cycles in iterated functions, so that the state after
+// a huge number of steps can be computed by skipping whole periods.
+//
+// States are compared by a key function, which allows for states that are
+// not comparable themselves, such as grids (see GridKey). The step function
+// must not modify its argument, as the algorithms keep several states around.
+package cycle
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Xjs/aoc2023/grid"
+)
+
+// A Cycle describes the sequence x0, step(x0), step(step(x0)), ...
+// which enters a loop after Start steps and then repeats every Period steps.
+type Cycle struct {
+	Start, Period int
+}
+
+// Index returns the smallest step number whose state equals the state after
+// n steps.
+func (c Cycle) Index(n int) int {
+	if n < c.Start {
+		return n
+	}
+	return c.Start + (n-c.Start)%c.Period
+}
+
+// Identity is a key function for states that are comparable themselves.
+func Identity[S comparable](s S) S {
+	return s
+}
+
+// GridKey is a key function for grid states. The key consists of the grid's
+// dimensions and the Go-syntax representation (%#v) of each cell, prefixed
+// with its length, so different grids never share a key.
+func GridKey[T any](g grid.Grid[T]) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%dx%d:", g.Width(), g.Height())
+	g.Foreach(func(p grid.Point) {
+		rep := fmt.Sprintf("%#v", g.MustAt(p))
+		fmt.Fprintf(&b, "%d:%s", len(rep), rep)
+	})
+	return b.String()
+}
+
+// Floyd detects the cycle using Floyd's tortoise and hare algorithm.
+func Floyd[S any, K comparable](x0 S, step func(S) S, key func(S) K) Cycle {
+	tortoise, hare := step(x0), step(step(x0))
+	for key(tortoise) != key(hare) {
+		tortoise, hare = step(tortoise), step(step(hare))
+	}
+
+	start := 0
+	tortoise = x0
+	for key(tortoise) != key(hare) {
+		tortoise, hare = step(tortoise), step(hare)
+		start++
+	}
+
+	period := 1
+	hare = step(tortoise)
+	for key(tortoise) != key(hare) {
+		hare = step(hare)
+		period++
+	}
+
+	return Cycle{Start: start, Period: period}
+}
+
+// Brent detects the cycle using Brent's algorithm, which usually needs
+// fewer steps than Floyd.
+func Brent[S any, K comparable](x0 S, step func(S) S, key func(S) K) Cycle {
+	power, period := 1, 1
+	tortoise, hare := x0, step(x0)
+	for key(tortoise) != key(hare) {
+		if power == period {
+			tortoise = hare
+			power *= 2
+			period = 0
+		}
+		hare = step(hare)
+		period++
+	}
+
+	tortoise, hare = x0, x0
+	for i := 0; i < period; i++ {
+		hare = step(hare)
+	}
+
+	start := 0
+	for key(tortoise) != key(hare) {
+		tortoise, hare = step(tortoise), step(hare)
+		start++
+	}
+
+	return Cycle{Start: start, Period: period}
+}
+
+// At returns the state after n steps, detecting the cycle with Brent's
+// algorithm and skipping all full periods.
+func At[S any, K comparable](x0 S, step func(S) S, key func(S) K, n int) S {
+	c := Brent(x0, step, key)
+	x := x0
+	for i := c.Index(n); i > 0; i-- {
+		x = step(x)
+	}
+	return x
+}
@@ -0,0 +1,84 @@
+package cycle
+
+import (
+	"testing"
+
+	"github.com/Xjs/aoc2023/grid"
+)
+
+func TestDetect(t *testing.T) {
+	// 0 -> 1 -> ... -> 4 -> 5 -> ... -> 11 -> 5
+	step := func(x int) int {
+		if x == 11 {
+			return 5
+		}
+		return x + 1
+	}
+	want := Cycle{Start: 5, Period: 7}
+
+	if got := Floyd(0, step, Identity[int]); got != want {
+		t.Errorf("Floyd() = %+v, want %+v", got, want)
+	}
+	if got := Brent(0, step, Identity[int]); got != want {
+		t.Errorf("Brent() = %+v, want %+v", got, want)
+	}
+
+	for _, n := range []int{0, 3, 5, 11, 12, 1000000000} {
+		want := 0
+		for i := 0; i < n%1000; i++ {
+			want = step(want)
+		}
+		if n >= 1000 {
+			want = 5 + (n-5)%7
+		}
+		if got := At(0, step, Identity[int], n); got != want {
+			t.Errorf("At(%d) = %d, want %d", n, got, want)
+		}
+	}
+}
+
+func TestAt_grid(t *testing.T) {
+	// shift a single mark to the right, wrapping around
+	start := grid.NewGrid[rune](5, 1)
+	start.Foreach(func(p grid.Point) { start.MustSet(p, '.') })
+	start.MustSet(grid.P(0, 0), '#')
+
+	step := func(g grid.Grid[rune]) grid.Grid[rune] {
+		next := grid.NewGrid[rune](g.Width(), g.Height())
+		g.Foreach(func(p grid.Point) {
+			next.MustSet(grid.P((p.X+1)%g.Width(), p.Y), g.MustAt(p))
+		})
+		return next
+	}
+
+	got := At(start, step, GridKey[rune], 1000000003)
+	if want := "...#.\n"; grid.StringCharGrid(got) != want {
+		t.Errorf("At() = %q, want %q", grid.StringCharGrid(got), want)
+	}
+}
+
+func TestGridKey(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b [][]string
+	}{
+		{"padding", [][]string{{"a", "bb"}}, [][]string{{" a", "bb"}}},
+		{"separator", [][]string{{"a b", "c"}}, [][]string{{"a", "b c"}}},
+		{"shape", [][]string{{"a", "b"}}, [][]string{{"a"}, {"b"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := grid.GridFrom(tt.a)
+			if err != nil {
+				t.Fatal(err)
+			}
+			b, err := grid.GridFrom(tt.b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ka, kb := GridKey(a), GridKey(b); ka == kb {
+				t.Errorf("GridKey() = %q for both grids", ka)
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package grid
+
+import (
+	"fmt"
+	"strings"
+)
+
+// A Change is a cell whose value differs between two grids.
+type Change[T any] struct {
+	Point    Point
+	Old, New T
+}
+
+func (c Change[T]) String() string {
+	return fmt.Sprintf("%v: %v -> %v", c.Point, c.Old, c.New)
+}
+
+// A Delta is the difference between two grids as returned by Diff.
+type Delta[T any] struct {
+	// SizeMismatch is true if the grids have different dimensions.
+	// Changes then only cover the area the grids have in common.
+	SizeMismatch bool
+	// Changes are the changed cells in row-major order.
+	Changes []Change[T]
+}
+
+// Empty returns true if the grids were equal.
+func (d Delta[T]) Empty() bool {
+	return !d.SizeMismatch && len(d.Changes) == 0
+}
+
+// Diff compares a and b cell by cell.
+func Diff[T comparable](a, b Grid[T]) Delta[T] {
+	d := Delta[T]{SizeMismatch: a.width != b.width || a.height != b.height}
+	for y := Coordinate(0); y < min(a.height, b.height); y++ {
+		for x := Coordinate(0); x < min(a.width, b.width); x++ {
+			old, new := a.values[y][x], b.values[y][x]
+			if old != new {
+				d.Changes = append(d.Changes, Change[T]{Point: P(x, y), Old: old, New: new})
+			}
+		}
+	}
+	return d
+}
+
+// String returns a line per change, preceded by a note if the dimensions
+// of the grids differ.
+func (d Delta[T]) String() string {
+	var b strings.Builder
+	if d.SizeMismatch {
+		b.WriteString("dimensions differ\n")
+	}
+	for _, c := range d.Changes {
+		b.WriteString(c.String())
+		b.WriteRune('\n')
+	}
+	return b.String()
+}
+
+// SideBySide renders a and b next to each other, followed by a third grid
+// in which all changed cells (and cells only present in one of the grids)
+// are marked with '*' and all other cells with '.'. Cells of a and b are
+// formatted using cell, or DefaultCell if cell is nil. It is meant for
+// test failure messages.
+func SideBySide[T comparable](a, b Grid[T], cell func(T) string) string {
+	w, h := max(a.width, b.width), max(a.height, b.height)
+	marks := NewGrid[rune](w, h)
+	for y := Coordinate(0); y < h; y++ {
+		for x := Coordinate(0); x < w; x++ {
+			p := P(x, y)
+			va, errA := a.At(p)
+			vb, errB := b.At(p)
+			if errA != nil || errB != nil || va != vb {
+				marks.MustSet(p, '*')
+			} else {
+				marks.MustSet(p, '.')
+			}
+		}
+	}
+
+	columns := [][]string{
+		strings.Split(strings.TrimSuffix(a.Render(FormatOptions[T]{Cell: cell}), "\n"), "\n"),
+		strings.Split(strings.TrimSuffix(b.Render(FormatOptions[T]{Cell: cell}), "\n"), "\n"),
+		strings.Split(strings.TrimSuffix(StringCharGrid(marks), "\n"), "\n"),
+	}
+	widths := make([]int, len(columns))
+	for i, lines := range columns {
+		for _, l := range lines {
+			widths[i] = max(widths[i], len([]rune(l)))
+		}
+	}
+
+	var sb strings.Builder
+	for y := 0; y < int(h); y++ {
+		for i, lines := range columns {
+			var l string
+			if y < len(lines) {
+				l = lines[y]
+			}
+			if i > 0 {
+				sb.WriteString(" | ")
+			}
+			if i < len(columns)-1 {
+				l += strings.Repeat(" ", widths[i]-len([]rune(l)))
+			}
+			sb.WriteString(l)
+		}
+		sb.WriteRune('\n')
+	}
+	return sb.String()
+}
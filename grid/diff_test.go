@@ -0,0 +1,41 @@
+package grid
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	a, _ := ReadRuneGrid(strings.NewReader("#..\n.#.\n..#"))
+	b, _ := ReadRuneGrid(strings.NewReader("#..\n...\n.##"))
+	c, _ := ReadRuneGrid(strings.NewReader("#.\n.#"))
+
+	d := Diff(*a, *b)
+	want := []Change[rune]{{P(1, 1), '#', '.'}, {P(1, 2), '.', '#'}}
+	if d.SizeMismatch || len(d.Changes) != len(want) {
+		t.Fatalf("Diff() = %v, want %v", d, want)
+	}
+	for i := range want {
+		if d.Changes[i] != want[i] {
+			t.Errorf("Diff().Changes[%d] = %v, want %v", i, d.Changes[i], want[i])
+		}
+	}
+
+	if d := Diff(*a, *a); !d.Empty() {
+		t.Errorf("Diff(a, a) = %v, want empty", d)
+	}
+
+	if d := Diff(*a, *c); !d.SizeMismatch || len(d.Changes) != 0 {
+		t.Errorf("Diff(a, c) = %+v, want size mismatch without changes", d)
+	}
+}
+
+func TestSideBySide(t *testing.T) {
+	a, _ := ReadRuneGrid(strings.NewReader("#..\n.#."))
+	b, _ := ReadRuneGrid(strings.NewReader("#.\n..\n.."))
+
+	want := "#.. | #. | ..*\n.#. | .. | .**\n    | .. | ***\n"
+	if got := SideBySide(*a, *b, CharCell); got != want {
+		t.Errorf("SideBySide() =\n%s\nwant\n%s", got, want)
+	}
+}
@@ -0,0 +1,77 @@
+package grid
+
+// Unreachable is the distance DistanceField assigns to cells that can't be
+// reached from any source.
+const Unreachable = -1
+
+// DistanceField performs a breadth-first search from all sources at once and
+// returns a grid holding, for each cell, the number of steps to the nearest
+// source. Cells for which passable returns false, or that can't be reached,
+// are set to Unreachable. env (usually g.Environment4 or g.Environment8)
+// returns the cells adjacent to a given cell. Sources that are out of bounds
+// or not passable are ignored.
+func DistanceField[T any](g Grid[T], sources []Point, passable func(T) bool, env func(Point) []Point) Grid[int] {
+	dist := NewGrid[int](g.width, g.height)
+	for y := Coordinate(0); y < g.height; y++ {
+		for x := Coordinate(0); x < g.width; x++ {
+			dist.values[y][x] = Unreachable
+		}
+	}
+
+	queue := make([]Point, 0, len(sources))
+	for _, s := range sources {
+		v, err := g.At(s)
+		if err != nil || !passable(v) || dist.MustAt(s) != Unreachable {
+			continue
+		}
+		dist.MustSet(s, 0)
+		queue = append(queue, s)
+	}
+
+	for len(queue) > 0 {
+		p := queue[0]
+		queue = queue[1:]
+		d := dist.MustAt(p)
+		for _, n := range env(p) {
+			if dist.MustAt(n) != Unreachable || !passable(g.MustAt(n)) {
+				continue
+			}
+			dist.MustSet(n, d+1)
+			queue = append(queue, n)
+		}
+	}
+
+	return dist
+}
+
+// CountWithin returns the number of reachable cells in dist whose distance is
+// at most n.
+func CountWithin(dist Grid[int], n int) int {
+	count := 0
+	for _, row := range dist.values {
+		for _, d := range row {
+			if d != Unreachable && d <= n {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+// CountWithinParity returns the number of reachable cells in dist whose
+// distance is at most n and has the same parity as n. With Environment4
+// adjacency, these are exactly the cells that can be reached in n steps
+// (provided every source has a passable neighbour), as a walk can go back
+// and forth to waste two steps and every cycle has even length. This doesn't
+// hold for Environment8 adjacency, which allows odd cycles.
+func CountWithinParity(dist Grid[int], n int) int {
+	count := 0
+	for _, row := range dist.values {
+		for _, d := range row {
+			if d != Unreachable && d <= n && (n-d)%2 == 0 {
+				count++
+			}
+		}
+	}
+	return count
+}
@@ -0,0 +1,55 @@
+package grid
+
+import (
+	"strings"
+	"testing"
+)
+
+const garden = `...........
+.....###.#.
+.###.##..#.
+..#.#...#..
+....#.#....
+.##..S####.
+.##..#...#.
+.......##..
+.##.#.####.
+.##..##.##.
+...........`
+
+func TestDistanceField(t *testing.T) {
+	g, err := ReadRuneGrid(strings.NewReader(garden))
+	if err != nil {
+		t.Fatal(err)
+	}
+	passable := func(r rune) bool { return r != '#' }
+	start := P(5, 5)
+
+	dist4 := DistanceField(*g, []Point{start}, passable, g.Environment4)
+	if got := CountWithinParity(dist4, 6); got != 16 {
+		t.Errorf("CountWithinParity(6) = %d, want 16", got)
+	}
+	if got := dist4.MustAt(P(5, 1)); got != Unreachable {
+		t.Errorf("distance of rock = %d, want %d", got, Unreachable)
+	}
+	if got := dist4.MustAt(P(0, 0)); got != 10 {
+		t.Errorf("distance of corner = %d, want 10", got)
+	}
+
+	open := NewGrid[rune](3, 3)
+	dist8 := DistanceField(open, []Point{P(1, 1)}, passable, open.Environment8)
+	if got := dist8.MustAt(P(0, 0)); got != 1 {
+		t.Errorf("8-distance of corner = %d, want 1", got)
+	}
+	if got := CountWithin(dist8, 1); got != 9 {
+		t.Errorf("CountWithin(1) with 8-adjacency = %d, want 9", got)
+	}
+
+	multi := DistanceField(*g, []Point{start, P(0, 0)}, passable, g.Environment4)
+	if got := multi.MustAt(P(1, 1)); got != 2 {
+		t.Errorf("multi-source distance = %d, want 2", got)
+	}
+	if got, want := CountWithin(multi, 0), 2; got != want {
+		t.Errorf("CountWithin(0) = %d, want %d", got, want)
+	}
+}
@@ -0,0 +1,138 @@
+package grid
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// FormatOptions controls how a grid is rendered as text.
+type FormatOptions[T any] struct {
+	// Cell formats a single cell value. If nil, DefaultCell is used.
+	Cell func(T) string
+	// Sep is written between two cells of a row. If empty, cells are separated
+	// by a single space as soon as any column is wider than one character.
+	Sep string
+	// Header adds row numbers to the left and column numbers (written
+	// vertically, one line per decimal digit) above the grid.
+	Header bool
+	// MinWidth is the minimum width of a column.
+	MinWidth int
+}
+
+// DefaultCell formats v using fmt.Sprint.
+func DefaultCell[T any](v T) string {
+	return fmt.Sprint(v)
+}
+
+// CharCell formats r as the character it represents. It is meant to be used
+// as FormatOptions.Cell for rune grids.
+func CharCell(r rune) string {
+	return string(r)
+}
+
+// Render creates a multi-line string from g according to o.
+// Cells are right-aligned to the width of the widest cell.
+func (g Grid[T]) Render(o FormatOptions[T]) string {
+	cell := o.Cell
+	if cell == nil {
+		cell = DefaultCell[T]
+	}
+
+	reps := make([][]string, g.height)
+	width := max(o.MinWidth, 1)
+	for y := Coordinate(0); y < g.height; y++ {
+		reps[y] = make([]string, g.width)
+		for x := Coordinate(0); x < g.width; x++ {
+			rep := cell(g.values[y][x])
+			reps[y][x] = rep
+			width = max(width, utf8.RuneCountInString(rep))
+		}
+	}
+
+	sep := o.Sep
+	if sep == "" && width > 1 {
+		sep = " "
+	}
+
+	var b strings.Builder
+
+	var labelWidth int
+	if o.Header {
+		labelWidth = len(fmt.Sprint(max(g.height, 1) - 1))
+		digits := len(fmt.Sprint(max(g.width, 1) - 1))
+		for d := 0; d < digits; d++ {
+			b.WriteString(strings.Repeat(" ", labelWidth+1))
+			for x := Coordinate(0); x < g.width; x++ {
+				if x > 0 {
+					b.WriteString(sep)
+				}
+				label := fmt.Sprintf("%*d", digits, x)
+				pad(&b, string(label[d]), width)
+			}
+			b.WriteRune('\n')
+		}
+	}
+
+	for y := Coordinate(0); y < g.height; y++ {
+		if o.Header {
+			fmt.Fprintf(&b, "%*d ", labelWidth, y)
+		}
+		for x := Coordinate(0); x < g.width; x++ {
+			if x > 0 {
+				b.WriteString(sep)
+			}
+			pad(&b, reps[y][x], width)
+		}
+		b.WriteRune('\n')
+	}
+
+	return b.String()
+}
+
+// pad writes s to b, right-aligned to width.
+func pad(b *strings.Builder, s string, width int) {
+	if n := width - utf8.RuneCountInString(s); n > 0 {
+		b.WriteString(strings.Repeat(" ", n))
+	}
+	b.WriteString(s)
+}
+
+// String creates a multi-line string from g using the default options.
+// A Grid[rune] is shown as code points; use StringCharGrid or CharCell to
+// show its characters instead.
+func (g Grid[T]) String() string {
+	return g.Render(FormatOptions[T]{})
+}
+
+// plainGrid has the fields of Grid, but none of its methods, so that fmt
+// formats it as a plain struct.
+type plainGrid[T any] Grid[T]
+
+// Format implements fmt.Formatter. The verbs %v and %s render the grid using
+// the default options; the '+' flag adds a coordinate header, and a width
+// sets the minimum column width. Other verbs format the grid as a struct,
+// applying the verb to its fields.
+func (g Grid[T]) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 'v', 's':
+		o := FormatOptions[T]{Header: f.Flag('+')}
+		if w, ok := f.Width(); ok {
+			o.MinWidth = w
+		}
+		fmt.Fprint(f, g.Render(o))
+	default:
+		fmt.Fprintf(f, fmt.FormatString(f, verb), plainGrid[T](g))
+	}
+}
+
+// StringIntGrid creates a multi-line string from an int grid.
+func StringIntGrid(g Grid[int]) string {
+	return g.String()
+}
+
+// StringCharGrid creates a multi-line string from a rune grid, showing each
+// cell as the character it represents.
+func StringCharGrid(g Grid[rune]) string {
+	return g.Render(FormatOptions[rune]{Cell: CharCell})
+}
@@ -0,0 +1,52 @@
+package grid
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestGrid_Render(t *testing.T) {
+	ints, _ := GridFrom([][]int{{1, -12, 3}, {100, 5, 6}})
+	digits, _ := GridFrom([][]int{{1, 2}, {3, 4}})
+	runes, _ := GridFrom([][]rune{[]rune("#.#"), []rune("..#")})
+	int32s, _ := GridFrom([][]int32{{65, 66}})
+
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{"negative", ints.String(), "  1 -12   3\n100   5   6\n"},
+		{"digits", digits.String(), "12\n34\n"},
+		{"int32", int32s.String(), "65 66\n"},
+		{"runes", runes.Render(FormatOptions[rune]{Cell: CharCell}), "#.#\n..#\n"},
+		{"sep", digits.Render(FormatOptions[int]{Sep: "|"}), "1|2\n3|4\n"},
+		{"cell", runes.Render(FormatOptions[rune]{Cell: func(r rune) string {
+			if r == '#' {
+				return "X"
+			}
+			return " "
+		}}), "X X\n  X\n"},
+		{"header", fmt.Sprintf("%+v", digits), "  01\n0 12\n1 34\n"},
+		{"width", fmt.Sprintf("%2v", digits), " 1  2\n 3  4\n"},
+		{"StringIntGrid", StringIntGrid(digits), "12\n34\n"},
+		{"StringCharGrid", StringCharGrid(runes), "#.#\n..#\n"},
+		{"other verb", fmt.Sprintf("%d", digits), "{2 2 [[1 2] [3 4]]}"},
+		{"other verb with flags", fmt.Sprintf("%03d", digits), "{002 002 [[001 002] [003 004]]}"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got\n%q\nwant\n%q", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGrid_Render_wideHeader(t *testing.T) {
+	g := NewGrid[int](12, 1)
+	want := "            11\n  012345678901\n0 000000000000\n"
+	if got := fmt.Sprintf("%+v", g); got != want {
+		t.Errorf("got\n%q\nwant\n%q", got, want)
+	}
+}
@@ -0,0 +1,166 @@
+package grid
+
+import "fmt"
+
+// An Edge is a weighted, directed connection to the node with index To.
+type Edge struct {
+	To     int
+	Weight int
+}
+
+// A Graph is a grid maze with its corridors contracted into weighted edges
+// between junctions.
+type Graph struct {
+	// Nodes contains the junctions of the maze.
+	Nodes []Point
+	// Index maps a junction to its index in Nodes.
+	Index map[Point]int
+	// Edges[i] contains the outgoing edges of Nodes[i].
+	Edges [][]Edge
+}
+
+// ToGraph contracts the corridors of g into edges between junctions.
+// A cell is passable if passable returns true for its value, and env
+// (usually g.Environment4) returns the cells adjacent to a given cell.
+// Junctions are all passable cells that don't have exactly two passable
+// neighbours, plus any extra points given (e. g. start and end).
+// The weight of an edge is the number of steps along the corridor.
+// It returns an error if an extra point is out of bounds or not passable.
+func ToGraph[T any](g Grid[T], passable func(T) bool, env func(Point) []Point, extra ...Point) (Graph, error) {
+	return ToDirectedGraph(g, passable, env, nil, extra...)
+}
+
+// ToDirectedGraph is ToGraph, but only creates an edge from one junction to
+// another if step returns true for every step along the corridor. This can be
+// used to model one-way tiles such as slopes. If step is nil, all steps are
+// allowed.
+func ToDirectedGraph[T any](g Grid[T], passable func(T) bool, env func(Point) []Point, step func(from, to Point) bool, extra ...Point) (Graph, error) {
+	for _, p := range extra {
+		v, err := g.At(p)
+		if err != nil {
+			return Graph{}, fmt.Errorf("extra point %v: %w", p, err)
+		}
+		if !passable(v) {
+			return Graph{}, fmt.Errorf("extra point %v is not passable", p)
+		}
+	}
+
+	open := func(p Point) bool {
+		return passable(g.MustAt(p))
+	}
+	neighbours := func(p Point) []Point {
+		var result []Point
+		for _, n := range env(p) {
+			if open(n) {
+				result = append(result, n)
+			}
+		}
+		return result
+	}
+	allowed := func(from, to Point) bool {
+		return step == nil || step(from, to)
+	}
+
+	gr := Graph{Index: make(map[Point]int)}
+	addNode := func(p Point) {
+		if _, ok := gr.Index[p]; ok {
+			return
+		}
+		gr.Index[p] = len(gr.Nodes)
+		gr.Nodes = append(gr.Nodes, p)
+	}
+
+	for y := Coordinate(0); y < g.height; y++ {
+		for x := Coordinate(0); x < g.width; x++ {
+			p := P(x, y)
+			if open(p) && len(neighbours(p)) != 2 {
+				addNode(p)
+			}
+		}
+	}
+	for _, p := range extra {
+		addNode(p)
+	}
+
+	gr.Edges = make([][]Edge, len(gr.Nodes))
+	for i, from := range gr.Nodes {
+		for _, next := range neighbours(from) {
+			prev, cur := from, next
+			ok := allowed(prev, cur)
+			length := 1
+			for ok {
+				if _, junction := gr.Index[cur]; junction {
+					break
+				}
+				for _, n := range neighbours(cur) {
+					if n != prev {
+						prev, cur = cur, n
+						break
+					}
+				}
+				ok = allowed(prev, cur)
+				length++
+			}
+			if ok {
+				gr.Edges[i] = append(gr.Edges[i], Edge{To: gr.Index[cur], Weight: length})
+			}
+		}
+	}
+
+	return gr, nil
+}
+
+// Slopes returns a step function for ToDirectedGraph that only allows leaving
+// a slope tile ('^', '>', 'v' or '<') in the direction it points to.
+func Slopes(g Grid[rune]) func(from, to Point) bool {
+	return func(from, to Point) bool {
+		switch g.MustAt(from) {
+		case '^':
+			return to.X == from.X && to.Y+1 == from.Y
+		case '>':
+			return to.X == from.X+1 && to.Y == from.Y
+		case 'v':
+			return to.X == from.X && to.Y == from.Y+1
+		case '<':
+			return to.X+1 == from.X && to.Y == from.Y
+		}
+		return true
+	}
+}
+
+// LongestPath returns the length of the longest simple path from one junction
+// to another, found by exhaustive search. It returns false if to can't be
+// reached from from, or if either of them is not a node of gr.
+func (gr Graph) LongestPath(from, to Point) (int, bool) {
+	start, ok := gr.Index[from]
+	if !ok {
+		return 0, false
+	}
+	end, ok := gr.Index[to]
+	if !ok {
+		return 0, false
+	}
+
+	visited := make([]bool, len(gr.Nodes))
+	best, found := 0, false
+
+	var walk func(n, length int)
+	walk = func(n, length int) {
+		if n == end {
+			if !found || length > best {
+				best, found = length, true
+			}
+			return
+		}
+		visited[n] = true
+		for _, e := range gr.Edges[n] {
+			if !visited[e.To] {
+				walk(e.To, length+e.Weight)
+			}
+		}
+		visited[n] = false
+	}
+	walk(start, 0)
+
+	return best, found
+}
@@ -0,0 +1,101 @@
+package grid
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+const slopeMaze = `#.#####################
+#.......#########...###
+#######.#########.#.###
+###.....#.>.>.###.#.###
+###v#####.#v#.###.#.###
+###.>...#.#.#.....#...#
+###v###.#.#.#########.#
+###...#.#.#.......#...#
+#####.#.#.#######.#.###
+#.....#.#.#.......#...#
+#.#####.#.#.#########v#
+#.#...#...#...###...>.#
+#.#.#v#######v###.###v#
+#...#.>.#...>.>.#.###.#
+#####v#.#.###v#.#.###.#
+#.....#...#...#.#.#...#
+#.#########.###.#.#.###
+#...###...#...#...#.###
+###.###.#.###v#####v###
+#...#...#.#.>.>.#.>.###
+#.###.###.#.###.#.#v###
+#.....###...###...#...#
+#####################.#`
+
+func TestGraph_LongestPath(t *testing.T) {
+	g, err := ReadRuneGrid(strings.NewReader(slopeMaze))
+	if err != nil {
+		t.Fatal(err)
+	}
+	passable := func(r rune) bool { return r != '#' }
+	start, end := P(1, 0), P(g.Width()-2, g.Height()-1)
+
+	tests := []struct {
+		name string
+		step func(from, to Point) bool
+		want int
+	}{
+		{"slopes", Slopes(*g), 94},
+		{"undirected", nil, 154},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gr, err := ToDirectedGraph(*g, passable, g.Environment4, tt.step)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, ok := gr.LongestPath(start, end)
+			if !ok || got != tt.want {
+				t.Errorf("Graph.LongestPath() = %d, %t, want %d, true", got, ok, tt.want)
+			}
+		})
+	}
+}
+
+func TestToGraph(t *testing.T) {
+	g, _ := ReadRuneGrid(strings.NewReader("#.#\n#..\n#.#"))
+	gr, err := ToGraph(*g, func(r rune) bool { return r == '.' }, g.Environment4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(gr.Nodes) != 4 {
+		t.Fatalf("got %d nodes %v, want 4", len(gr.Nodes), gr.Nodes)
+	}
+	centre := gr.Index[P(1, 1)]
+	if got := len(gr.Edges[centre]); got != 3 {
+		t.Errorf("got %d edges from centre, want 3", got)
+	}
+	for _, e := range gr.Edges[centre] {
+		if e.Weight != 1 {
+			t.Errorf("edge %v: got weight %d, want 1", e, e.Weight)
+		}
+	}
+}
+
+func TestToGraph_extra(t *testing.T) {
+	g, _ := ReadRuneGrid(strings.NewReader("#.#\n#..\n#.#"))
+	passable := func(r rune) bool { return r == '.' }
+
+	gr, err := ToGraph(*g, passable, g.Environment4, P(2, 1))
+	if err != nil {
+		t.Fatalf("ToGraph() with passable extra: %v", err)
+	}
+	if _, ok := gr.Index[P(2, 1)]; !ok {
+		t.Errorf("extra point %v is not a node", P(2, 1))
+	}
+
+	if _, err := ToGraph(*g, passable, g.Environment4, P(10, 0)); !errors.Is(err, ErrOutOfBounds) {
+		t.Errorf("ToGraph() with out-of-bounds extra: got error %v, want ErrOutOfBounds", err)
+	}
+	if _, err := ToGraph(*g, passable, g.Environment4, P(0, 0)); err == nil {
+		t.Error("ToGraph() with impassable extra: got no error")
+	}
+}
@@ -5,7 +5,6 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"strings"
 
 	"github.com/Xjs/aoc2023/parse"
 )
@@ -70,14 +69,14 @@ func GridFrom[T any](values [][]T) (Grid[T], error) {
 }
 
 // ReadIntGrid reads digit lists from r until EOF is encountered,
-// and creates a grid from them.
+// and creates a grid from them. Invalid digits are reported as *parse.Error.
 func ReadIntGrid(r io.Reader) (*Grid[int], error) {
 	var values [][]int
 	s := bufio.NewScanner(r)
 	for s.Scan() {
 		ds, err := parse.DigitList(s.Text())
 		if err != nil {
-			return nil, err
+			return nil, parse.AtLine(err, len(values)+1)
 		}
 		values = append(values, ds)
 	}
@@ -194,56 +193,6 @@ func (g *Grid[T]) MustSet(p Point, v T) {
 	}
 }
 
-// String creates a multi-line string from an int grid.
-func StringIntGrid(g Grid[int]) string {
-	var b strings.Builder
-	var max int
-	for x := Coordinate(0); x < g.width; x++ {
-		for y := Coordinate(0); y < g.height; y++ {
-			if v := g.MustAt(P(x, y)); v > max {
-				max = v
-			}
-		}
-	}
-
-	l := len(fmt.Sprint(max))
-	sep := ""
-	fill := ' '
-	if l > 1 {
-		sep = " "
-	}
-
-	for y := Coordinate(0); y < g.height; y++ {
-		for x := Coordinate(0); x < g.width; x++ {
-			v := g.MustAt(P(x, y))
-			rep := fmt.Sprint(v)
-			for i := 0; i < l-len(rep); i++ {
-				b.WriteRune(fill)
-			}
-			b.WriteString(rep)
-			b.WriteString(sep)
-		}
-		b.WriteRune('\n')
-	}
-
-	return b.String()
-}
-
-// String creates a multi-line string from an int grid.
-func StringCharGrid(g Grid[rune]) string {
-	var b strings.Builder
-
-	for y := Coordinate(0); y < g.height; y++ {
-		for x := Coordinate(0); x < g.width; x++ {
-			v := g.MustAt(P(x, y))
-			b.WriteRune(rune(v))
-		}
-		b.WriteRune('\n')
-	}
-
-	return b.String()
-}
-
 // Foreach calls f exactly once for each point in g.
 func (g *Grid[T]) Foreach(f func(p Point)) {
 	for x := Coordinate(0); x < g.Width(); x++ {
@@ -0,0 +1,250 @@
+package grid
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/Xjs/aoc2023/parse"
+)
+
+// A Point3 represents a point on a three-dimensional grid.
+type Point3 struct {
+	X, Y, Z Coordinate
+}
+
+// P3 is a convenience constructor for Point3.
+func P3(x, y, z Coordinate) Point3 {
+	return Point3{X: x, Y: y, Z: z}
+}
+
+// A Grid3 represents a three-dimensional cuboid grid of Ts.
+type Grid3[T any] struct {
+	width, height, depth Coordinate
+	values               []T
+}
+
+// Width returns the grid's width (extent in X direction).
+func (g Grid3[T]) Width() Coordinate {
+	return g.width
+}
+
+// Height returns the grid's height (extent in Y direction).
+func (g Grid3[T]) Height() Coordinate {
+	return g.height
+}
+
+// Depth returns the grid's depth (extent in Z direction).
+func (g Grid3[T]) Depth() Coordinate {
+	return g.depth
+}
+
+// NewGrid3 creates a new zero-filled grid with the given dimensions.
+func NewGrid3[T any](w, h, d Coordinate) Grid3[T] {
+	return Grid3[T]{width: w, height: h, depth: d, values: make([]T, w*h*d)}
+}
+
+func (g Grid3[T]) inBounds(p Point3) bool {
+	return p.X < g.width && p.Y < g.height && p.Z < g.depth
+}
+
+func (g Grid3[T]) index(p Point3) Coordinate {
+	return (p.Z*g.height+p.Y)*g.width + p.X
+}
+
+// At returns the value at the given point. It returns ErrOutOfBounds if
+// an out-of-bounds point is attempted to be read.
+func (g Grid3[T]) At(p Point3) (T, error) {
+	var zero T
+	if !g.inBounds(p) {
+		return zero, ErrOutOfBounds
+	}
+	return g.values[g.index(p)], nil
+}
+
+// MustAt is At, but panics instead of returning an error.
+func (g Grid3[T]) MustAt(p Point3) T {
+	v, err := g.At(p)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Set sets the given grid point to the given value. It returns ErrOutOfBounds if
+// an out-of-bounds point is attempted to be set.
+func (g *Grid3[T]) Set(p Point3, v T) error {
+	if g == nil {
+		return errors.New("grid is nil")
+	}
+
+	if !g.inBounds(p) {
+		return ErrOutOfBounds
+	}
+
+	g.values[g.index(p)] = v
+	return nil
+}
+
+// MustSet is Set, but panics instead of returning an error.
+func (g *Grid3[T]) MustSet(p Point3, v T) {
+	if err := g.Set(p, v); err != nil {
+		panic(err)
+	}
+}
+
+// Foreach calls f exactly once for each point in g.
+func (g *Grid3[T]) Foreach(f func(p Point3)) {
+	for z := Coordinate(0); z < g.depth; z++ {
+		for y := Coordinate(0); y < g.height; y++ {
+			for x := Coordinate(0); x < g.width; x++ {
+				f(P3(x, y, z))
+			}
+		}
+	}
+}
+
+// environment returns the points around p whose offset in each axis is
+// in {-1, 0, 1} and whose number of non-zero offsets is at most maxChanged.
+// Any points that would be out of bounds are not returned.
+func (g Grid3[T]) environment(p Point3, maxChanged int) []Point3 {
+	var result []Point3
+	for dz := -1; dz <= 1; dz++ {
+		for dy := -1; dy <= 1; dy++ {
+			for dx := -1; dx <= 1; dx++ {
+				changed := dx*dx + dy*dy + dz*dz
+				if changed == 0 || changed > maxChanged {
+					continue
+				}
+				// Underflow wraps around to a huge value, which is out of bounds.
+				n := P3(p.X+Coordinate(dx), p.Y+Coordinate(dy), p.Z+Coordinate(dz))
+				if g.inBounds(n) {
+					result = append(result, n)
+				}
+			}
+		}
+	}
+	return result
+}
+
+// Environment6 returns a slice of points that represent the 6-environment
+// of p, i. e. the points sharing a face with p. Any points would be
+// out of bounds are not returned.
+func (g Grid3[T]) Environment6(p Point3) []Point3 {
+	return g.environment(p, 1)
+}
+
+// Environment26 returns a slice of points that represent the 26-environment
+// of p, i. e. the points sharing a face, an edge or a corner with p.
+// Any points would be out of bounds are not returned.
+func (g Grid3[T]) Environment26(p Point3) []Point3 {
+	return g.environment(p, 3)
+}
+
+// Exterior returns a grid that is true for every cell that is not solid and
+// can be reached from the boundary of g through non-solid cells using
+// Environment6 adjacency. Non-solid cells that are not exterior are enclosed
+// pockets.
+func Exterior[T any](g Grid3[T], solid func(T) bool) Grid3[bool] {
+	ext := NewGrid3[bool](g.width, g.height, g.depth)
+	var stack []Point3
+	g.Foreach(func(p Point3) {
+		onBoundary := p.X == 0 || p.Y == 0 || p.Z == 0 ||
+			p.X == g.width-1 || p.Y == g.height-1 || p.Z == g.depth-1
+		if onBoundary && !solid(g.MustAt(p)) {
+			ext.MustSet(p, true)
+			stack = append(stack, p)
+		}
+	})
+
+	for len(stack) > 0 {
+		p := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, n := range g.Environment6(p) {
+			if ext.MustAt(n) || solid(g.MustAt(n)) {
+				continue
+			}
+			ext.MustSet(n, true)
+			stack = append(stack, n)
+		}
+	}
+	return ext
+}
+
+// SurfaceArea returns the number of faces of solid cells that don't touch
+// another solid cell. Faces on the boundary of g are counted.
+func SurfaceArea[T any](g Grid3[T], solid func(T) bool) int {
+	return surfaceArea(g, solid, func(Point3) bool { return true })
+}
+
+// ExteriorSurfaceArea is SurfaceArea, but only counts faces that touch the
+// exterior (see Exterior), i. e. it ignores enclosed pockets.
+func ExteriorSurfaceArea[T any](g Grid3[T], solid func(T) bool) int {
+	ext := Exterior(g, solid)
+	return surfaceArea(g, solid, ext.MustAt)
+}
+
+func surfaceArea[T any](g Grid3[T], solid func(T) bool, counts func(Point3) bool) int {
+	area := 0
+	g.Foreach(func(p Point3) {
+		if !solid(g.MustAt(p)) {
+			return
+		}
+		neighbours := g.Environment6(p)
+		area += 6 - len(neighbours)
+		for _, n := range neighbours {
+			if !solid(g.MustAt(n)) && counts(n) {
+				area++
+			}
+		}
+	})
+	return area
+}
+
+// ReadPoints3 reads lines of comma-separated "x,y,z" coordinates from r
+// until EOF is encountered.
+func ReadPoints3(r io.Reader) ([]Point3, error) {
+	var points []Point3
+	s := bufio.NewScanner(r)
+	for line := 1; s.Scan(); line++ {
+		ns, err := parse.IntList(s.Text())
+		if err != nil {
+			return nil, parse.AtLine(err, line)
+		}
+		if len(ns) != 3 {
+			return nil, &parse.Error{Line: line, Input: s.Text(), Err: fmt.Errorf("want 3 coordinates, got %d", len(ns))}
+		}
+		for _, n := range ns {
+			if n < 0 {
+				return nil, &parse.Error{Line: line, Input: s.Text(), Err: fmt.Errorf("negative coordinate %d", n)}
+			}
+		}
+		points = append(points, P3(Coordinate(ns[0]), Coordinate(ns[1]), Coordinate(ns[2])))
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	return points, nil
+}
+
+// ReadVoxelGrid reads points like ReadPoints3 and creates the smallest grid
+// starting at the origin that contains all of them, with the cells at the
+// given points set to true.
+func ReadVoxelGrid(r io.Reader) (*Grid3[bool], error) {
+	points, err := ReadPoints3(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var w, h, d Coordinate
+	for _, p := range points {
+		w, h, d = max(w, p.X+1), max(h, p.Y+1), max(d, p.Z+1)
+	}
+
+	g := NewGrid3[bool](w, h, d)
+	for _, p := range points {
+		g.MustSet(p, true)
+	}
+	return &g, nil
+}
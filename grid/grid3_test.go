@@ -0,0 +1,64 @@
+package grid
+
+import (
+	"strings"
+	"testing"
+)
+
+const droplet = `2,2,2
+1,2,2
+3,2,2
+2,1,2
+2,3,2
+2,2,1
+2,2,3
+2,2,4
+2,2,6
+1,2,5
+3,2,5
+2,1,5
+2,3,5`
+
+func TestSurfaceArea(t *testing.T) {
+	g, err := ReadVoxelGrid(strings.NewReader(droplet))
+	if err != nil {
+		t.Fatal(err)
+	}
+	solid := func(b bool) bool { return b }
+	if got := SurfaceArea(*g, solid); got != 64 {
+		t.Errorf("SurfaceArea() = %d, want 64", got)
+	}
+	if got := ExteriorSurfaceArea(*g, solid); got != 58 {
+		t.Errorf("ExteriorSurfaceArea() = %d, want 58", got)
+	}
+}
+
+func TestGrid3_Environment(t *testing.T) {
+	g := NewGrid3[int](3, 3, 3)
+	tests := []struct {
+		name string
+		got  []Point3
+		want int
+	}{
+		{"6 centre", g.Environment6(P3(1, 1, 1)), 6},
+		{"6 corner", g.Environment6(P3(0, 0, 0)), 3},
+		{"26 centre", g.Environment26(P3(1, 1, 1)), 26},
+		{"26 corner", g.Environment26(P3(2, 2, 2)), 7},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if len(tt.got) != tt.want {
+				t.Errorf("got %d points %v, want %d", len(tt.got), tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReadPoints3(t *testing.T) {
+	if _, err := ReadPoints3(strings.NewReader("1,2")); err == nil {
+		t.Error("expected error for two coordinates")
+	}
+	if _, err := ReadPoints3(strings.NewReader("1,-2,3")); err == nil {
+		t.Error("expected error for negative coordinate")
+	}
+}
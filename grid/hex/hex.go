@@ -0,0 +1,228 @@
+// Package hex provides hexagonal grids using axial coordinates.
+package hex
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Xjs/aoc2023/grid"
+)
+
+// A Hex is a hexagon in axial coordinates. The third cube coordinate is
+// implied by Q+R+S = 0, see S.
+type Hex struct {
+	Q, R int
+}
+
+// H is a convenience constructor for Hex.
+func H(q, r int) Hex {
+	return Hex{Q: q, R: r}
+}
+
+// S returns the third cube coordinate of h.
+func (h Hex) S() int {
+	return -h.Q - h.R
+}
+
+// Add returns h+o.
+func (h Hex) Add(o Hex) Hex {
+	return H(h.Q+o.Q, h.R+o.R)
+}
+
+// Scale returns h multiplied by k.
+func (h Hex) Scale(k int) Hex {
+	return H(k*h.Q, k*h.R)
+}
+
+// Len returns the distance of h to the origin in steps.
+func (h Hex) Len() int {
+	return max(abs(h.Q), abs(h.R), abs(h.S()))
+}
+
+// Distance returns the number of steps between a and b.
+func Distance(a, b Hex) int {
+	return H(a.Q-b.Q, a.R-b.R).Len()
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+// Neighbours are the offsets of the six neighbours of a hexagon, starting
+// with +Q and going counter-clockwise in cube coordinate order.
+var Neighbours = [6]Hex{H(1, 0), H(1, -1), H(0, -1), H(-1, 0), H(-1, 1), H(0, 1)}
+
+// Ring returns the hexagons at exactly the given distance from center,
+// walking around the ring. A radius of 0 returns center.
+func Ring(center Hex, radius int) []Hex {
+	if radius == 0 {
+		return []Hex{center}
+	}
+	result := make([]Hex, 0, 6*radius)
+	h := center.Add(Neighbours[4].Scale(radius))
+	for _, d := range Neighbours {
+		for i := 0; i < radius; i++ {
+			result = append(result, h)
+			h = h.Add(d)
+		}
+	}
+	return result
+}
+
+// PointyDirections name the neighbours of a pointy-top hexagon
+// (rows are horizontal).
+var PointyDirections = map[string]Hex{
+	"e":  H(1, 0),
+	"ne": H(1, -1),
+	"nw": H(0, -1),
+	"w":  H(-1, 0),
+	"sw": H(-1, 1),
+	"se": H(0, 1),
+}
+
+// FlatDirections name the neighbours of a flat-top hexagon
+// (columns are vertical).
+var FlatDirections = map[string]Hex{
+	"n":  H(0, -1),
+	"ne": H(1, -1),
+	"se": H(1, 0),
+	"s":  H(0, 1),
+	"sw": H(-1, 1),
+	"nw": H(-1, 0),
+}
+
+// ParseDirections parses a comma-separated list of direction names such as
+// "nw,ne,se" using directions (PointyDirections or FlatDirections) and returns
+// the corresponding offsets.
+func ParseDirections(s string, directions map[string]Hex) ([]Hex, error) {
+	var result []Hex
+	for _, field := range strings.Split(s, ",") {
+		field = strings.TrimSpace(field)
+		d, ok := directions[field]
+		if !ok {
+			return nil, fmt.Errorf("invalid direction %q in %q", field, s)
+		}
+		result = append(result, d)
+	}
+	return result, nil
+}
+
+// A Grid represents a hexagon-shaped grid of Ts with a given radius around
+// the origin.
+type Grid[T any] struct {
+	radius int
+	values [][]T
+}
+
+// NewGrid creates a new zero-filled grid with the given radius.
+func NewGrid[T any](radius int) Grid[T] {
+	n := 2*radius + 1
+	g := Grid[T]{radius: radius, values: make([][]T, n)}
+	for i := range g.values {
+		g.values[i] = make([]T, n)
+	}
+	return g
+}
+
+// Radius returns the grid's radius.
+func (g Grid[T]) Radius() int {
+	return g.radius
+}
+
+// Contains returns whether h is on the grid.
+func (g Grid[T]) Contains(h Hex) bool {
+	return h.Len() <= g.radius
+}
+
+// At returns the value at the given hexagon. It returns grid.ErrOutOfBounds if
+// an out-of-bounds hexagon is attempted to be read.
+func (g Grid[T]) At(h Hex) (T, error) {
+	var zero T
+	if !g.Contains(h) {
+		return zero, grid.ErrOutOfBounds
+	}
+	return g.values[h.R+g.radius][h.Q+g.radius], nil
+}
+
+// MustAt is At, but panics instead of returning an error.
+func (g Grid[T]) MustAt(h Hex) T {
+	v, err := g.At(h)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Set sets the given hexagon to the given value. It returns grid.ErrOutOfBounds if
+// an out-of-bounds hexagon is attempted to be set.
+func (g *Grid[T]) Set(h Hex, v T) error {
+	if g == nil {
+		return errors.New("grid is nil")
+	}
+	if !g.Contains(h) {
+		return grid.ErrOutOfBounds
+	}
+	g.values[h.R+g.radius][h.Q+g.radius] = v
+	return nil
+}
+
+// MustSet is Set, but panics instead of returning an error.
+func (g *Grid[T]) MustSet(h Hex, v T) {
+	if err := g.Set(h, v); err != nil {
+		panic(err)
+	}
+}
+
+// Environment returns the neighbours of h. Any hexagons that would be
+// out of bounds are not returned.
+func (g Grid[T]) Environment(h Hex) []Hex {
+	result := make([]Hex, 0, 6)
+	for _, d := range Neighbours {
+		if n := h.Add(d); g.Contains(n) {
+			result = append(result, n)
+		}
+	}
+	return result
+}
+
+// Foreach calls f exactly once for each hexagon in g, row by row.
+func (g *Grid[T]) Foreach(f func(h Hex)) {
+	for r := -g.radius; r <= g.radius; r++ {
+		for q := max(-g.radius, -r-g.radius); q <= min(g.radius, -r+g.radius); q++ {
+			f(H(q, r))
+		}
+	}
+}
+
+// String creates a multi-line string from g in pointy-top layout, with each
+// row indented by half a hexagon per step away from the middle row.
+// Cells are formatted using grid.DefaultCell, so a Grid[rune] is shown as
+// code points; use StringCharGrid to show its characters instead.
+func (g Grid[T]) String() string {
+	return g.render(grid.DefaultCell[T])
+}
+
+func (g Grid[T]) render(cell func(T) string) string {
+	var b strings.Builder
+	for r := -g.radius; r <= g.radius; r++ {
+		b.WriteString(strings.Repeat(" ", abs(r)))
+		for q := max(-g.radius, -r-g.radius); q <= min(g.radius, -r+g.radius); q++ {
+			if q > max(-g.radius, -r-g.radius) {
+				b.WriteRune(' ')
+			}
+			b.WriteString(cell(g.MustAt(H(q, r))))
+		}
+		b.WriteRune('\n')
+	}
+	return b.String()
+}
+
+// StringCharGrid creates a multi-line string from a rune grid, showing each
+// cell as the character it represents.
+func StringCharGrid(g Grid[rune]) string {
+	return g.render(grid.CharCell)
+}
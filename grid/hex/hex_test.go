@@ -0,0 +1,77 @@
+package hex
+
+import (
+	"testing"
+)
+
+func TestParseDirections(t *testing.T) {
+	tests := []struct {
+		input string
+		want  int
+	}{
+		{"ne,ne,ne", 3},
+		{"ne,ne,sw,sw", 0},
+		{"ne,ne,s,s", 2},
+		{"se,sw,se,sw,sw", 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			ds, err := ParseDirections(tt.input, FlatDirections)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var h Hex
+			for _, d := range ds {
+				h = h.Add(d)
+			}
+			if got := Distance(H(0, 0), h); got != tt.want {
+				t.Errorf("distance = %d, want %d", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := ParseDirections("n,e", FlatDirections); err == nil {
+		t.Error("expected error for invalid direction")
+	}
+}
+
+func TestRing(t *testing.T) {
+	for radius := 0; radius < 5; radius++ {
+		ring := Ring(H(2, -1), radius)
+		want := max(1, 6*radius)
+		if len(ring) != want {
+			t.Errorf("len(Ring(%d)) = %d, want %d", radius, len(ring), want)
+		}
+		seen := make(map[Hex]bool)
+		for _, h := range ring {
+			if d := Distance(H(2, -1), h); d != radius {
+				t.Errorf("Ring(%d) contains %v at distance %d", radius, h, d)
+			}
+			if seen[h] {
+				t.Errorf("Ring(%d) contains %v twice", radius, h)
+			}
+			seen[h] = true
+		}
+	}
+}
+
+func TestGrid(t *testing.T) {
+	g := NewGrid[rune](1)
+	g.Foreach(func(h Hex) { g.MustSet(h, '.') })
+	g.MustSet(H(0, 0), '#')
+
+	if err := g.Set(H(2, 0), '#'); err == nil {
+		t.Error("expected out of bounds error")
+	}
+	if got := len(g.Environment(H(0, 0))); got != 6 {
+		t.Errorf("len(Environment(origin)) = %d, want 6", got)
+	}
+	if got := len(g.Environment(H(1, 0))); got != 3 {
+		t.Errorf("len(Environment(edge)) = %d, want 3", got)
+	}
+
+	want := " . .\n. # .\n . .\n"
+	if got := StringCharGrid(g); got != want {
+		t.Errorf("StringCharGrid() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,167 @@
+package grid
+
+import (
+	"errors"
+	"fmt"
+)
+
+// A Direction is one of the four directions of the 4-environment.
+type Direction int
+
+const (
+	North Direction = iota
+	East
+	South
+	West
+)
+
+// Opposite returns the direction opposite to d.
+func (d Direction) Opposite() Direction {
+	return (d + 2) % 4
+}
+
+func (d Direction) String() string {
+	switch d {
+	case North:
+		return "N"
+	case East:
+		return "E"
+	case South:
+		return "S"
+	case West:
+		return "W"
+	}
+	return fmt.Sprintf("Direction(%d)", int(d))
+}
+
+// Step returns the point next to p in direction d. It returns false if that
+// point would be out of bounds.
+func (g Grid[T]) Step(p Point, d Direction) (Point, bool) {
+	switch d {
+	case North:
+		if p.Y > 0 {
+			return P(p.X, p.Y-1), true
+		}
+	case East:
+		if p.X < g.width-1 {
+			return P(p.X+1, p.Y), true
+		}
+	case South:
+		if p.Y < g.height-1 {
+			return P(p.X, p.Y+1), true
+		}
+	case West:
+		if p.X > 0 {
+			return P(p.X-1, p.Y), true
+		}
+	}
+	return p, false
+}
+
+// Pipes are the connectors of the usual pipe characters.
+var Pipes = map[rune][]Direction{
+	'|': {North, South},
+	'-': {East, West},
+	'L': {North, East},
+	'J': {North, West},
+	'7': {South, West},
+	'F': {East, South},
+}
+
+// connects reports whether the tile at p has a connector in direction d.
+func connects(g Grid[rune], connectors map[rune][]Direction, p Point, d Direction) bool {
+	for _, c := range connectors[g.MustAt(p)] {
+		if c == d {
+			return true
+		}
+	}
+	return false
+}
+
+// ConnectivityGraph returns the adjacency of the tiles in g, where connectors
+// maps a tile to the directions it connects to. Two tiles are only adjacent
+// if their connections are mutual, i. e. both tiles connect to each other.
+func ConnectivityGraph(g Grid[rune], connectors map[rune][]Direction) map[Point][]Point {
+	adjacency := make(map[Point][]Point)
+	for y := Coordinate(0); y < g.height; y++ {
+		for x := Coordinate(0); x < g.width; x++ {
+			p := P(x, y)
+			for _, d := range connectors[g.MustAt(p)] {
+				n, ok := g.Step(p, d)
+				if ok && connects(g, connectors, n, d.Opposite()) {
+					adjacency[p] = append(adjacency[p], n)
+				}
+			}
+		}
+	}
+	return adjacency
+}
+
+// ErrNoTile is returned by InferTile if no tile or more than one tile fits.
+var ErrNoTile = errors.New("no unique tile fits")
+
+// InferTile returns the tile hidden under p (e. g. a start marker), i. e. the
+// tile whose connectors match exactly the neighbours connecting to p.
+func InferTile(g Grid[rune], p Point, connectors map[rune][]Direction) (rune, error) {
+	var want [4]bool
+	for d := North; d <= West; d++ {
+		n, ok := g.Step(p, d)
+		want[d] = ok && connects(g, connectors, n, d.Opposite())
+	}
+
+	var found []rune
+	for tile, ds := range connectors {
+		var have [4]bool
+		for _, d := range ds {
+			have[d] = true
+		}
+		if have == want {
+			found = append(found, tile)
+		}
+	}
+	if len(found) != 1 {
+		return 0, fmt.Errorf("tile at %v: %w (candidates %q)", p, ErrNoTile, string(found))
+	}
+	return found[0], nil
+}
+
+// Loop infers the tile under start and returns the loop through start as an
+// ordered cycle of points, beginning with start.
+func Loop(g Grid[rune], start Point, connectors map[rune][]Direction) ([]Point, error) {
+	tile, err := InferTile(g, start, connectors)
+	if err != nil {
+		return nil, err
+	}
+
+	next := func(prev, cur Point) (Point, error) {
+		t := g.MustAt(cur)
+		if cur == start {
+			t = tile
+		}
+		for _, d := range connectors[t] {
+			n, ok := g.Step(cur, d)
+			if !ok || n == prev {
+				continue
+			}
+			if n != start && !connects(g, connectors, n, d.Opposite()) {
+				continue
+			}
+			return n, nil
+		}
+		return cur, fmt.Errorf("loop is broken at %v", cur)
+	}
+
+	loop := []Point{start}
+	prev, cur := start, start
+	for {
+		n, err := next(prev, cur)
+		if err != nil {
+			return nil, err
+		}
+		if n == start {
+			return loop, nil
+		}
+		loop = append(loop, n)
+		prev, cur = cur, n
+	}
+}
@@ -0,0 +1,56 @@
+package grid
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLoop(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		start Point
+		tile  rune
+		want  int
+	}{
+		{"square", "-L|F7\n7S-7|\nL|7||\n-L-J|\nL|-JF", P(1, 1), 'F', 8},
+		{"complex", "7-F7-\n.FJ|7\nSJLL7\n|F--J\nLJ.LJ", P(0, 2), 'F', 16},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g, err := ReadRuneGrid(strings.NewReader(tt.input))
+			if err != nil {
+				t.Fatal(err)
+			}
+			tile, err := InferTile(*g, tt.start, Pipes)
+			if err != nil || tile != tt.tile {
+				t.Errorf("InferTile() = %q, %v, want %q", tile, err, tt.tile)
+			}
+			loop, err := Loop(*g, tt.start, Pipes)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(loop) != tt.want {
+				t.Errorf("len(Loop()) = %d, want %d", len(loop), tt.want)
+			}
+			for i, p := range loop {
+				q := loop[(i+1)%len(loop)]
+				dx, dy := int(p.X)-int(q.X), int(p.Y)-int(q.Y)
+				if dx*dx+dy*dy != 1 {
+					t.Errorf("loop points %v and %v aren't adjacent", p, q)
+				}
+			}
+		})
+	}
+}
+
+func TestConnectivityGraph(t *testing.T) {
+	g, _ := ReadRuneGrid(strings.NewReader("F7|\nLJ-"))
+	adj := ConnectivityGraph(*g, Pipes)
+	if got := len(adj[P(0, 0)]); got != 2 {
+		t.Errorf("got %d neighbours of F, want 2", got)
+	}
+	if got := len(adj[P(2, 0)]); got != 0 {
+		t.Errorf("got %d neighbours of dangling |, want 0", got)
+	}
+}
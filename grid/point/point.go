@@ -1 +1,121 @@
+// Package point provides signed two-dimensional vectors, which can represent
+// offsets and positions outside of a grid.
 package point
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/Xjs/aoc2023/grid"
+)
+
+// A Vec is a two-dimensional vector with signed coordinates.
+// As on a grid, Y grows downwards.
+type Vec struct {
+	X, Y int64
+}
+
+// V is a convenience constructor for Vec.
+func V(x, y int64) Vec {
+	return Vec{X: x, Y: y}
+}
+
+// The unit vectors in the four directions.
+var (
+	Up    = V(0, -1)
+	Right = V(1, 0)
+	Down  = V(0, 1)
+	Left  = V(-1, 0)
+)
+
+func (v Vec) String() string {
+	return fmt.Sprintf("(%d,%d)", v.X, v.Y)
+}
+
+// Add returns v+w.
+func (v Vec) Add(w Vec) Vec {
+	return V(v.X+w.X, v.Y+w.Y)
+}
+
+// Sub returns v-w.
+func (v Vec) Sub(w Vec) Vec {
+	return V(v.X-w.X, v.Y-w.Y)
+}
+
+// Scale returns v multiplied by k.
+func (v Vec) Scale(k int64) Vec {
+	return V(k*v.X, k*v.Y)
+}
+
+// Neg returns -v.
+func (v Vec) Neg() Vec {
+	return V(-v.X, -v.Y)
+}
+
+// Rotate90 returns v rotated by 90 degrees clockwise (as seen on a grid,
+// where Y grows downwards), e. g. Up becomes Right.
+func (v Vec) Rotate90() Vec {
+	return V(-v.Y, v.X)
+}
+
+// Manhattan returns the Manhattan (L1) length of v.
+func (v Vec) Manhattan() int64 {
+	return abs(v.X) + abs(v.Y)
+}
+
+// Chebyshev returns the Chebyshev (L∞) length of v.
+func (v Vec) Chebyshev() int64 {
+	return max(abs(v.X), abs(v.Y))
+}
+
+// Dot returns the dot product of v and w.
+func (v Vec) Dot(w Vec) int64 {
+	return v.X*w.X + v.Y*w.Y
+}
+
+// Cross returns the z component of the cross product of v and w.
+func (v Vec) Cross(w Vec) int64 {
+	return v.X*w.Y - v.Y*w.X
+}
+
+// Min returns the component-wise minimum of v and w.
+func Min(v, w Vec) Vec {
+	return V(min(v.X, w.X), min(v.Y, w.Y))
+}
+
+// Max returns the component-wise maximum of v and w.
+func Max(v, w Vec) Vec {
+	return V(max(v.X, w.X), max(v.Y, w.Y))
+}
+
+func abs(x int64) int64 {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+// ErrOverflow is returned by FromPoint if a coordinate doesn't fit into an int64.
+var ErrOverflow = errors.New("coordinate out of range")
+
+// FromPoint converts a grid point to a Vec. It returns ErrOverflow if either
+// coordinate of p exceeds math.MaxInt64.
+func FromPoint(p grid.Point) (Vec, error) {
+	if uint64(p.X) > math.MaxInt64 || uint64(p.Y) > math.MaxInt64 {
+		return Vec{}, fmt.Errorf("%v: %w", p, ErrOverflow)
+	}
+	return V(int64(p.X), int64(p.Y)), nil
+}
+
+// ErrUnderflow is returned by Point if a coordinate is negative.
+var ErrUnderflow = errors.New("negative coordinate")
+
+// Point converts v to a grid point. It returns ErrUnderflow if either
+// coordinate of v is negative.
+func (v Vec) Point() (grid.Point, error) {
+	if v.X < 0 || v.Y < 0 {
+		return grid.Point{}, fmt.Errorf("%v: %w", v, ErrUnderflow)
+	}
+	return grid.P(grid.Coordinate(v.X), grid.Coordinate(v.Y)), nil
+}
@@ -0,0 +1,93 @@
+package point
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/Xjs/aoc2023/grid"
+)
+
+func TestVec_Rotate90(t *testing.T) {
+	tests := []struct {
+		v, want Vec
+	}{
+		{Up, Right},
+		{Right, Down},
+		{Down, Left},
+		{Left, Up},
+		{V(2, -3), V(3, 2)},
+	}
+	for _, tt := range tests {
+		if got := tt.v.Rotate90(); got != tt.want {
+			t.Errorf("%v.Rotate90() = %v, want %v", tt.v, got, tt.want)
+		}
+	}
+}
+
+func TestVec_Point(t *testing.T) {
+	tests := []struct {
+		name    string
+		v       Vec
+		want    grid.Point
+		wantErr error
+	}{
+		{"origin", V(0, 0), grid.P(0, 0), nil},
+		{"positive", V(3, 4), grid.P(3, 4), nil},
+		{"negative x", V(-1, 4), grid.Point{}, ErrUnderflow},
+		{"negative y", V(1, -4), grid.Point{}, ErrUnderflow},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.v.Point()
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Vec.Point() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Vec.Point() = %v, want %v", got, tt.want)
+			}
+			if err != nil {
+				return
+			}
+			if back, err := FromPoint(got); err != nil || back != tt.v {
+				t.Errorf("FromPoint(%v) = %v, %v, want %v", got, back, err, tt.v)
+			}
+		})
+	}
+}
+
+func TestFromPoint_overflow(t *testing.T) {
+	for _, p := range []grid.Point{grid.P(math.MaxInt64+1, 0), grid.P(0, math.MaxUint)} {
+		if _, err := FromPoint(p); !errors.Is(err, ErrOverflow) {
+			t.Errorf("FromPoint(%v) error = %v, want ErrOverflow", p, err)
+		}
+	}
+	if got, err := FromPoint(grid.P(math.MaxInt64, 0)); err != nil || got != V(math.MaxInt64, 0) {
+		t.Errorf("FromPoint(MaxInt64, 0) = %v, %v", got, err)
+	}
+}
+
+func TestVec_metrics(t *testing.T) {
+	a, b := V(1, -2), V(-4, 3)
+	if got := a.Sub(b).Manhattan(); got != 10 {
+		t.Errorf("Manhattan = %d, want 10", got)
+	}
+	if got := a.Sub(b).Chebyshev(); got != 5 {
+		t.Errorf("Chebyshev = %d, want 5", got)
+	}
+	if got := a.Dot(b); got != -10 {
+		t.Errorf("Dot = %d, want -10", got)
+	}
+	if got := a.Cross(b); got != -5 {
+		t.Errorf("Cross = %d, want -5", got)
+	}
+	if got := Min(a, b); got != V(-4, -2) {
+		t.Errorf("Min = %v, want (-4,-2)", got)
+	}
+	if got := Max(a, b); got != V(1, 3) {
+		t.Errorf("Max = %v, want (1,3)", got)
+	}
+	if got := a.Add(b.Neg()).Scale(2); got != V(10, -10) {
+		t.Errorf("Scale = %v, want (10,-10)", got)
+	}
+}
@@ -0,0 +1,96 @@
+package grid
+
+import "fmt"
+
+// A Region is a 4-connected set of cells that all hold the same value.
+type Region[T any] struct {
+	Value  T
+	Points []Point
+
+	// Area is the number of cells in the region.
+	Area int
+	// Perimeter is the number of cell edges bordering on another region or
+	// the outside of the grid.
+	Perimeter int
+	// Sides is the number of straight sides of the region's outline,
+	// including the outlines of holes. It equals the number of corners.
+	Sides int
+}
+
+func (r Region[T]) String() string {
+	return fmt.Sprintf("region %v at %v: area %d, perimeter %d, sides %d",
+		r.Value, r.Points[0], r.Area, r.Perimeter, r.Sides)
+}
+
+// Regions finds all regions of g using Environment4 adjacency, and computes
+// their area, perimeter and number of sides.
+func Regions[T comparable](g Grid[T]) []Region[T] {
+	// label holds the 1-based index of the region each cell belongs to.
+	label := NewGrid[int](g.width, g.height)
+	var regions []Region[T]
+
+	for y := Coordinate(0); y < g.height; y++ {
+		for x := Coordinate(0); x < g.width; x++ {
+			p := P(x, y)
+			if label.MustAt(p) != 0 {
+				continue
+			}
+
+			r := Region[T]{Value: g.MustAt(p)}
+			id := len(regions) + 1
+			label.MustSet(p, id)
+			stack := []Point{p}
+			for len(stack) > 0 {
+				cur := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				r.Points = append(r.Points, cur)
+
+				neighbours := g.Environment4(cur)
+				r.Perimeter += 4
+				for _, n := range neighbours {
+					if g.MustAt(n) != r.Value {
+						continue
+					}
+					r.Perimeter--
+					if label.MustAt(n) == 0 {
+						label.MustSet(n, id)
+						stack = append(stack, n)
+					}
+				}
+			}
+			r.Area = len(r.Points)
+			r.Sides = corners(label, r.Points, id)
+			regions = append(regions, r)
+		}
+	}
+
+	return regions
+}
+
+// corners counts the corners of the region labelled id in label.
+func corners(label Grid[int], points []Point, id int) int {
+	in := func(x, y int) bool {
+		if x < 0 || y < 0 {
+			return false
+		}
+		v, err := label.At(P(Coordinate(x), Coordinate(y)))
+		return err == nil && v == id
+	}
+
+	count := 0
+	for _, p := range points {
+		x, y := int(p.X), int(p.Y)
+		for _, d := range [][2]int{{-1, -1}, {1, -1}, {1, 1}, {-1, 1}} {
+			a, b, c := in(x+d[0], y), in(x, y+d[1]), in(x+d[0], y+d[1])
+			switch {
+			case !a && !b:
+				// convex corner
+				count++
+			case a && b && !c:
+				// concave corner
+				count++
+			}
+		}
+	}
+	return count
+}
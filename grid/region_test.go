@@ -0,0 +1,41 @@
+package grid
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRegions(t *testing.T) {
+	tests := []struct {
+		name                         string
+		input                        string
+		regions, perimeter, sidesSum int
+	}{
+		{"simple", "AAAA\nBBCD\nBBCC\nEEEC", 5, 140, 80},
+		{"holes", "OOOOO\nOXOXO\nOOOOO\nOXOXO\nOOOOO", 5, 772, 436},
+		{"e-shape", "EEEEE\nEXXXX\nEEEEE\nEXXXX\nEEEEE", 3, 692, 236},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g, err := ReadRuneGrid(strings.NewReader(tt.input))
+			if err != nil {
+				t.Fatal(err)
+			}
+			regions := Regions(*g)
+			if len(regions) != tt.regions {
+				t.Errorf("got %d regions, want %d", len(regions), tt.regions)
+			}
+			perimeter, sides := 0, 0
+			for _, r := range regions {
+				perimeter += r.Area * r.Perimeter
+				sides += r.Area * r.Sides
+			}
+			if perimeter != tt.perimeter {
+				t.Errorf("sum of area × perimeter = %d, want %d", perimeter, tt.perimeter)
+			}
+			if sides != tt.sidesSum {
+				t.Errorf("sum of area × sides = %d, want %d", sides, tt.sidesSum)
+			}
+		})
+	}
+}
@@ -0,0 +1,196 @@
+package integer
+
+import (
+	"errors"
+	"math/big"
+)
+
+// ErrOverflow is returned if the result of an operation doesn't fit into
+// the integer type.
+var ErrOverflow = errors.New("integer overflow")
+
+// signed returns true if T is a signed integer type.
+func signed[T Integer]() bool {
+	var zero T
+	return zero-1 < zero
+}
+
+// CheckedAdd returns a+b, or ErrOverflow if the sum doesn't fit into T.
+func CheckedAdd[T Integer](a, b T) (T, error) {
+	c := a + b
+	if signed[T]() {
+		if b > 0 && c < a || b < 0 && c > a {
+			return c, ErrOverflow
+		}
+	} else if c < a {
+		return c, ErrOverflow
+	}
+	return c, nil
+}
+
+// CheckedMul returns a*b, or ErrOverflow if the product doesn't fit into T.
+func CheckedMul[T Integer](a, b T) (T, error) {
+	if a == 0 || b == 0 {
+		return 0, nil
+	}
+	c := a * b
+	if c/b != a {
+		return c, ErrOverflow
+	}
+	// The division above doesn't detect -1 * MinInt, as MinInt / -1 == MinInt.
+	var zero T
+	if signed[T]() && (a == zero-1 && b == -b || b == zero-1 && a == -a) {
+		return c, ErrOverflow
+	}
+	return c, nil
+}
+
+// CheckedSum is Sum, but returns ErrOverflow if an intermediate sum doesn't
+// fit into T.
+func CheckedSum[T Integer](ns []T) (T, error) {
+	var sum T
+	for _, n := range ns {
+		var err error
+		sum, err = CheckedAdd(sum, n)
+		if err != nil {
+			return sum, err
+		}
+	}
+	return sum, nil
+}
+
+// CheckedProduct is Product, but returns ErrOverflow if an intermediate
+// product doesn't fit into T.
+func CheckedProduct[T Integer](ns []T) (T, error) {
+	product := T(1)
+	for _, n := range ns {
+		if n == 0 {
+			return 0, nil
+		}
+	}
+	for _, n := range ns {
+		var err error
+		product, err = CheckedMul(product, n)
+		if err != nil {
+			return product, err
+		}
+	}
+	return product, nil
+}
+
+// A BigNumber is an integer that is stored as an int64 as long as it fits, and
+// is transparently promoted to a big.Int otherwise. BigNumbers are immutable;
+// the zero value is 0.
+type BigNumber struct {
+	small int64
+	// large is only set if the value doesn't fit into an int64.
+	large *big.Int
+}
+
+// N returns x as a BigNumber.
+func N[T Integer](x T) BigNumber {
+	if signed[T]() || uint64(x) <= 1<<63-1 {
+		return BigNumber{small: int64(x)}
+	}
+	return fromBigInt(new(big.Int).SetUint64(uint64(x)))
+}
+
+// NBig returns x as a BigNumber.
+func NBig(x *big.Int) BigNumber {
+	return fromBigInt(new(big.Int).Set(x))
+}
+
+// fromBigInt returns b as a BigNumber, taking ownership of b.
+func fromBigInt(b *big.Int) BigNumber {
+	if b.IsInt64() {
+		return BigNumber{small: b.Int64()}
+	}
+	return BigNumber{large: b}
+}
+
+// Big returns n as a new big.Int.
+func (n BigNumber) Big() *big.Int {
+	if n.large != nil {
+		return new(big.Int).Set(n.large)
+	}
+	return big.NewInt(n.small)
+}
+
+// Int64 returns n as an int64. It returns false if n doesn't fit.
+func (n BigNumber) Int64() (int64, bool) {
+	return n.small, n.large == nil
+}
+
+// IsBig returns true if n has been promoted to a big.Int.
+func (n BigNumber) IsBig() bool {
+	return n.large != nil
+}
+
+func (n BigNumber) String() string {
+	return n.Big().String()
+}
+
+// Add returns n+m.
+func (n BigNumber) Add(m BigNumber) BigNumber {
+	if n.large == nil && m.large == nil {
+		if c, err := CheckedAdd(n.small, m.small); err == nil {
+			return BigNumber{small: c}
+		}
+	}
+	return fromBigInt(new(big.Int).Add(n.Big(), m.Big()))
+}
+
+// Sub returns n-m.
+func (n BigNumber) Sub(m BigNumber) BigNumber {
+	return n.Add(m.Neg())
+}
+
+// Neg returns -n.
+func (n BigNumber) Neg() BigNumber {
+	if n.large == nil && n.small != -1<<63 {
+		return BigNumber{small: -n.small}
+	}
+	return fromBigInt(new(big.Int).Neg(n.Big()))
+}
+
+// Mul returns n*m.
+func (n BigNumber) Mul(m BigNumber) BigNumber {
+	if n.large == nil && m.large == nil {
+		if c, err := CheckedMul(n.small, m.small); err == nil {
+			return BigNumber{small: c}
+		}
+	}
+	return fromBigInt(new(big.Int).Mul(n.Big(), m.Big()))
+}
+
+// Cmp compares n and m and returns -1, 0 or +1 like big.Int.Cmp.
+func (n BigNumber) Cmp(m BigNumber) int {
+	if n.large == nil && m.large == nil {
+		switch {
+		case n.small < m.small:
+			return -1
+		case n.small > m.small:
+			return 1
+		}
+		return 0
+	}
+	return n.Big().Cmp(m.Big())
+}
+
+// SumBigNumbers returns the sum of ns as a BigNumber, which never overflows.
+func SumBigNumbers[T Integer](ns []T) BigNumber {
+	var sum BigNumber
+	for _, n := range ns {
+		sum = sum.Add(N(n))
+	}
+	return sum
+}
+
+// ProductBigNumbers returns the product of ns as a BigNumber, which never overflows.
+func ProductBigNumbers[T Integer](ns []T) BigNumber {
+	product := N(1)
+	for _, n := range ns {
+		product = product.Mul(N(n))
+	}
+	return product
+}
@@ -0,0 +1,78 @@
+package integer
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestChecked(t *testing.T) {
+	tests := []struct {
+		name     string
+		f        func() (int64, error)
+		want     int64
+		overflow bool
+	}{
+		{"add", func() (int64, error) { return CheckedAdd[int64](1, 2) }, 3, false},
+		{"add overflow", func() (int64, error) { return CheckedAdd[int64](math.MaxInt64, 1) }, 0, true},
+		{"add underflow", func() (int64, error) { return CheckedAdd[int64](math.MinInt64, -1) }, 0, true},
+		{"mul", func() (int64, error) { return CheckedMul[int64](-3, 4) }, -12, false},
+		{"mul overflow", func() (int64, error) { return CheckedMul[int64](1<<32, 1<<31) }, 0, true},
+		{"mul min", func() (int64, error) { return CheckedMul[int64](-1, math.MinInt64) }, 0, true},
+		{"sum", func() (int64, error) { return CheckedSum([]int64{math.MaxInt64, 1, -2}) }, 0, true},
+		{"product", func() (int64, error) { return CheckedProduct([]int64{1 << 40, 1 << 40, 0}) }, 0, false},
+		{"product overflow", func() (int64, error) { return CheckedProduct([]int64{1 << 40, 1 << 40}) }, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.f()
+			if errors.Is(err, ErrOverflow) != tt.overflow {
+				t.Fatalf("error = %v, want overflow %t", err, tt.overflow)
+			}
+			if !tt.overflow && got != tt.want {
+				t.Errorf("got %d, want %d", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := CheckedAdd[uint8](200, 56); !errors.Is(err, ErrOverflow) {
+		t.Errorf("CheckedAdd[uint8] error = %v, want ErrOverflow", err)
+	}
+	if _, err := CheckedMul[uint8](16, 16); !errors.Is(err, ErrOverflow) {
+		t.Errorf("CheckedMul[uint8] error = %v, want ErrOverflow", err)
+	}
+}
+
+func TestBigNumber(t *testing.T) {
+	max := N(int64(math.MaxInt64))
+	sum := max.Add(N(1))
+	if !sum.IsBig() || sum.String() != "9223372036854775808" {
+		t.Errorf("MaxInt64 + 1 = %v", sum)
+	}
+	if back := sum.Sub(N(1)); back.IsBig() || back.Cmp(max) != 0 {
+		t.Errorf("MaxInt64 + 1 - 1 = %v, big %t", back, back.IsBig())
+	}
+
+	product := ProductBigNumbers([]int{1 << 40, 1 << 40, 1 << 40})
+	if want := new(big.Int).Lsh(big.NewInt(1), 120); product.Big().Cmp(want) != 0 {
+		t.Errorf("ProductBigNumbers() = %v, want %v", product, want)
+	}
+	if _, ok := product.Int64(); ok {
+		t.Error("product fits into int64")
+	}
+
+	if got := SumBigNumbers([]uint64{math.MaxUint64, math.MaxUint64}); got.String() != "36893488147419103230" {
+		t.Errorf("SumBigNumbers() = %v", got)
+	}
+
+	if got := N(int64(math.MinInt64)).Neg(); got.String() != "9223372036854775808" {
+		t.Errorf("-MinInt64 = %v", got)
+	}
+	if N(3).Cmp(NBig(big.NewInt(3))) != 0 || N(2).Cmp(sum) != -1 || sum.Cmp(N(2)) != 1 {
+		t.Error("Cmp is wrong")
+	}
+	if n, ok := N(-5).Mul(N(7)).Int64(); !ok || n != -35 {
+		t.Errorf("-5 * 7 = %d, %t", n, ok)
+	}
+}
@@ -0,0 +1,128 @@
+package integer
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// Differences returns the differences between consecutive elements of seq.
+func Differences[T Number](seq []T) []T {
+	if len(seq) < 2 {
+		return nil
+	}
+	diffs := make([]T, len(seq)-1)
+	for i := range diffs {
+		diffs[i] = seq[i+1] - seq[i]
+	}
+	return diffs
+}
+
+// Extrapolate continues seq by repeated differences: for steps > 0, it returns
+// the value steps positions after the last element, for steps < 0 the value
+// -steps positions before the first element. For steps == 0, it returns the
+// last element. The result is exact even if intermediate values don't fit
+// into T; ErrOverflow is returned if the result itself doesn't.
+func Extrapolate[T Integer](seq []T, steps int) (T, error) {
+	if len(seq) == 0 {
+		return 0, errors.New("cannot extrapolate empty sequence")
+	}
+
+	x := int64(steps)
+	if steps >= 0 {
+		x += int64(len(seq) - 1)
+	}
+
+	// Newton's forward difference formula:
+	// f(x) = sum over k of Δ^k f(0) * binomial(x, k)
+	row := make([]*big.Int, len(seq))
+	for i, v := range seq {
+		row[i] = toBig(v)
+	}
+	result := new(big.Int)
+	binomial := big.NewInt(1)
+	for k := 0; len(row) > 0; k++ {
+		if k > 0 {
+			// binomial(x, k) = binomial(x, k-1) * (x-k+1) / k, which is exact
+			binomial.Mul(binomial, big.NewInt(x-int64(k)+1))
+			binomial.Quo(binomial, big.NewInt(int64(k)))
+		}
+		result.Add(result, new(big.Int).Mul(row[0], binomial))
+
+		allZero := true
+		next := make([]*big.Int, len(row)-1)
+		for i := range next {
+			next[i] = new(big.Int).Sub(row[i+1], row[i])
+			allZero = allZero && next[i].Sign() == 0
+		}
+		if allZero {
+			break
+		}
+		row = next
+	}
+
+	return fromBig[T](result)
+}
+
+func toBig[T Integer](v T) *big.Int {
+	if signed[T]() {
+		return big.NewInt(int64(v))
+	}
+	return new(big.Int).SetUint64(uint64(v))
+}
+
+func fromBig[T Integer](b *big.Int) (T, error) {
+	var v T
+	if signed[T]() {
+		if !b.IsInt64() || int64(T(b.Int64())) != b.Int64() {
+			return 0, fmt.Errorf("%v: %w", b, ErrOverflow)
+		}
+		v = T(b.Int64())
+	} else {
+		if !b.IsUint64() || uint64(T(b.Uint64())) != b.Uint64() {
+			return 0, fmt.Errorf("%v: %w", b, ErrOverflow)
+		}
+		v = T(b.Uint64())
+	}
+	return v, nil
+}
+
+// Lagrange returns the value at x of the polynomial of minimal degree through
+// the points (xs[i], ys[i]), computed exactly by Lagrange interpolation.
+// The xs must be distinct.
+func Lagrange(xs, ys []*big.Rat, x *big.Rat) (*big.Rat, error) {
+	if len(xs) != len(ys) {
+		return nil, fmt.Errorf("got %d x values, but %d y values", len(xs), len(ys))
+	}
+
+	result := new(big.Rat)
+	for i := range xs {
+		term := new(big.Rat).Set(ys[i])
+		for j := range xs {
+			if i == j {
+				continue
+			}
+			denom := new(big.Rat).Sub(xs[i], xs[j])
+			if denom.Sign() == 0 {
+				return nil, fmt.Errorf("duplicate x value %v", xs[i])
+			}
+			term.Mul(term, new(big.Rat).Sub(x, xs[j]))
+			term.Quo(term, denom)
+		}
+		result.Add(result, term)
+	}
+	return result, nil
+}
+
+// LagrangeInt is Lagrange for integer sample points. The result may be
+// fractional, see big.Rat.IsInt.
+func LagrangeInt[T Integer](xs, ys []T, x T) (*big.Rat, error) {
+	toRats := func(vs []T) []*big.Rat {
+		rats := make([]*big.Rat, len(vs))
+		for i, v := range vs {
+			rats[i] = new(big.Rat).SetInt(toBig(v))
+		}
+		return rats
+	}
+	return Lagrange(toRats(xs), toRats(ys), new(big.Rat).SetInt(toBig(x)))
+}
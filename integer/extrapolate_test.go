@@ -0,0 +1,75 @@
+package integer
+
+import (
+	"errors"
+	"math/big"
+	"reflect"
+	"testing"
+)
+
+func TestExtrapolate(t *testing.T) {
+	tests := []struct {
+		name  string
+		seq   []int
+		steps int
+		want  int
+	}{
+		{"linear forward", []int{0, 3, 6, 9, 12, 15}, 1, 18},
+		{"quadratic forward", []int{1, 3, 6, 10, 15, 21}, 1, 28},
+		{"cubic forward", []int{10, 13, 16, 21, 30, 45}, 1, 68},
+		{"linear backward", []int{0, 3, 6, 9, 12, 15}, -1, -3},
+		{"quadratic backward", []int{1, 3, 6, 10, 15, 21}, -1, 0},
+		{"cubic backward", []int{10, 13, 16, 21, 30, 45}, -1, 5},
+		{"far", []int{1, 3, 6, 10}, 1000, 1004 * 1005 / 2},
+		{"far backward", []int{1, 3, 6, 10}, -10, 9 * 8 / 2},
+		{"zero", []int{7, 8}, 0, 8},
+		{"single", []int{7}, 5, 7},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Extrapolate(tt.seq, tt.steps)
+			if err != nil || got != tt.want {
+				t.Errorf("Extrapolate() = %d, %v, want %d", got, err, tt.want)
+			}
+		})
+	}
+
+	if _, err := Extrapolate([]int8{100, 110, 120}, 1); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Extrapolate() error = %v, want ErrOverflow", err)
+	}
+	if _, err := Extrapolate([]uint{1, 2}, -5); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Extrapolate() error = %v, want ErrOverflow", err)
+	}
+}
+
+func TestDifferences(t *testing.T) {
+	if got, want := Differences([]int{1, 3, 6, 10}), []int{2, 3, 4}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Differences() = %v, want %v", got, want)
+	}
+	if got := Differences([]int{1}); got != nil {
+		t.Errorf("Differences() = %v, want nil", got)
+	}
+}
+
+func TestLagrange(t *testing.T) {
+	// quadratic growth: f(65), f(196), f(327) sampled, evaluated far away
+	f := func(x int64) int64 { return 3*x*x - 7*x + 11 }
+	xs := []int64{65, 196, 327}
+	ys := []int64{f(65), f(196), f(327)}
+	got, err := LagrangeInt(xs, ys, 26501365)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := big.NewRat(f(26501365), 1); got.Cmp(want) != 0 {
+		t.Errorf("LagrangeInt() = %v, want %v", got, want)
+	}
+
+	half, err := LagrangeInt([]int{0, 2}, []int{0, 1}, 1)
+	if err != nil || half.Cmp(big.NewRat(1, 2)) != 0 {
+		t.Errorf("LagrangeInt() = %v, %v, want 1/2", half, err)
+	}
+
+	if _, err := LagrangeInt([]int{1, 1}, []int{2, 3}, 0); err == nil {
+		t.Error("expected error for duplicate x values")
+	}
+}
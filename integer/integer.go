@@ -1,30 +1,109 @@
 package integer
 
-import "math"
+import "cmp"
 
-// Sum returns the sum of the list of integers
-func Sum(ns []int) int {
-	var sum int
+// Sum returns the sum of the list of numbers, which is 0 for an empty list.
+func Sum[T Number](ns []T) T {
+	var sum T
 	for _, n := range ns {
 		sum += n
 	}
 	return sum
 }
 
+// Product returns the product of the list of numbers, which is 1 for an
+// empty list.
+func Product[T Number](ns []T) T {
+	product := T(1)
+	for _, n := range ns {
+		product *= n
+	}
+	return product
+}
+
+// Count returns the number of elements of xs for which pred returns true.
+func Count[T any](xs []T, pred func(T) bool) int {
+	count := 0
+	for _, x := range xs {
+		if pred(x) {
+			count++
+		}
+	}
+	return count
+}
+
 // Abs returns the absolute value of x.
-func Abs(x int) int {
+func Abs[T Number](x T) T {
 	if x < 0 {
 		return -x
 	}
 	return x
 }
 
-func Max(xs []int) int {
-	max := math.MinInt
-	for _, x := range xs {
-		if x > max {
-			max = x
+// ArgMax returns the index of the first maximum of xs.
+// It returns false if xs is empty.
+func ArgMax[T cmp.Ordered](xs []T) (int, bool) {
+	if len(xs) == 0 {
+		return 0, false
+	}
+	arg := 0
+	for i, x := range xs {
+		if x > xs[arg] {
+			arg = i
+		}
+	}
+	return arg, true
+}
+
+// ArgMin returns the index of the first minimum of xs.
+// It returns false if xs is empty.
+func ArgMin[T cmp.Ordered](xs []T) (int, bool) {
+	if len(xs) == 0 {
+		return 0, false
+	}
+	arg := 0
+	for i, x := range xs {
+		if x < xs[arg] {
+			arg = i
+		}
+	}
+	return arg, true
+}
+
+// Max returns the maximum of xs. It returns false if xs is empty.
+func Max[T cmp.Ordered](xs []T) (T, bool) {
+	var zero T
+	i, ok := ArgMax(xs)
+	if !ok {
+		return zero, false
+	}
+	return xs[i], true
+}
+
+// Min returns the minimum of xs. It returns false if xs is empty.
+func Min[T cmp.Ordered](xs []T) (T, bool) {
+	var zero T
+	i, ok := ArgMin(xs)
+	if !ok {
+		return zero, false
+	}
+	return xs[i], true
+}
+
+// MinMax returns the minimum and maximum of xs in a single pass.
+// It returns false if xs is empty.
+func MinMax[T cmp.Ordered](xs []T) (lo, hi T, ok bool) {
+	if len(xs) == 0 {
+		return lo, hi, false
+	}
+	lo, hi = xs[0], xs[0]
+	for _, x := range xs[1:] {
+		if x < lo {
+			lo = x
+		}
+		if x > hi {
+			hi = x
 		}
 	}
-	return max
+	return lo, hi, true
 }
@@ -0,0 +1,61 @@
+package integer
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAggregates(t *testing.T) {
+	ints := []int{3, -7, 9, 9, -7, 2}
+
+	if got := Sum(ints); got != 9 {
+		t.Errorf("Sum() = %d, want 9", got)
+	}
+	if got := Product([]float64{1.5, 2, -2}); got != -6 {
+		t.Errorf("Product() = %v, want -6", got)
+	}
+	if got := Product([]int(nil)); got != 1 {
+		t.Errorf("Product(nil) = %d, want 1", got)
+	}
+	if got := Count(ints, func(x int) bool { return x < 0 }); got != 2 {
+		t.Errorf("Count() = %d, want 2", got)
+	}
+	if got := Abs(int8(-128) + 1); got != 127 {
+		t.Errorf("Abs() = %d, want 127", got)
+	}
+	if got := Abs(-2.5); got != 2.5 {
+		t.Errorf("Abs() = %v, want 2.5", got)
+	}
+
+	if got, ok := Max(ints); !ok || got != 9 {
+		t.Errorf("Max() = %d, %t, want 9, true", got, ok)
+	}
+	if got, ok := Min(ints); !ok || got != -7 {
+		t.Errorf("Min() = %d, %t, want -7, true", got, ok)
+	}
+	if got, ok := ArgMax(ints); !ok || got != 2 {
+		t.Errorf("ArgMax() = %d, %t, want 2, true", got, ok)
+	}
+	if got, ok := ArgMin(ints); !ok || got != 1 {
+		t.Errorf("ArgMin() = %d, %t, want 1, true", got, ok)
+	}
+	if lo, hi, ok := MinMax([]string{"b", "c", "a"}); !ok || lo != "a" || hi != "c" {
+		t.Errorf("MinMax() = %q, %q, %t, want \"a\", \"c\", true", lo, hi, ok)
+	}
+
+	if got, ok := Max([]int{math.MinInt}); !ok || got != math.MinInt {
+		t.Errorf("Max({MinInt}) = %d, %t, want MinInt, true", got, ok)
+	}
+	if _, ok := Max([]int(nil)); ok {
+		t.Error("Max(nil) returned ok")
+	}
+	if _, ok := Min([]int(nil)); ok {
+		t.Error("Min(nil) returned ok")
+	}
+	if _, _, ok := MinMax([]int(nil)); ok {
+		t.Error("MinMax(nil) returned ok")
+	}
+	if _, ok := ArgMax([]int(nil)); ok {
+		t.Error("ArgMax(nil) returned ok")
+	}
+}
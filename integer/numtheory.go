@@ -0,0 +1,227 @@
+package integer
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"math/bits"
+	"slices"
+)
+
+// GCD returns the greatest common divisor of a and b, which is never negative,
+// except if it doesn't fit into T: For signed T, GCD(min, 0) and
+// GCD(min, min) return min, where min is the minimum value of T.
+// GCD(0, 0) is 0.
+func GCD[T Integer](a, b T) T {
+	// Only take the absolute value at the end, as Abs overflows for min.
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return Abs(a)
+}
+
+// GCDAll returns the greatest common divisor of all xs, or 0 if there are none.
+func GCDAll[T Integer](xs ...T) T {
+	var g T
+	for _, x := range xs {
+		g = GCD(g, x)
+	}
+	return g
+}
+
+// LCM returns the least common multiple of a and b, which is never negative.
+// It returns ErrOverflow if the result doesn't fit into T.
+func LCM[T Integer](a, b T) (T, error) {
+	if a == 0 || b == 0 {
+		return 0, nil
+	}
+	a, b = Abs(a), Abs(b)
+	if a < 0 || b < 0 {
+		// Abs overflowed for the minimum of T
+		return 0, ErrOverflow
+	}
+	return CheckedMul(a/GCD(a, b), b)
+}
+
+// LCMAll returns the least common multiple of all xs, e. g. the step at which
+// several cycles with the given periods align. It returns 1 if there are no xs
+// and ErrOverflow if the result doesn't fit into T.
+func LCMAll[T Integer](xs ...T) (T, error) {
+	l := T(1)
+	for _, x := range xs {
+		var err error
+		l, err = LCM(l, x)
+		if err != nil {
+			return 0, fmt.Errorf("LCM of %v: %w", xs, err)
+		}
+	}
+	return l, nil
+}
+
+// ExtendedGCD returns g = GCD(a, b) and Bézout coefficients x and y such that
+// a*x + b*y = g.
+func ExtendedGCD[T Signed](a, b T) (g, x, y T) {
+	oldR, r := a, b
+	oldS, s := T(1), T(0)
+	oldT, t := T(0), T(1)
+	for r != 0 {
+		q := oldR / r
+		oldR, r = r, oldR-q*r
+		oldS, s = s, oldS-q*s
+		oldT, t = t, oldT-q*t
+	}
+	if oldR < 0 {
+		return -oldR, -oldS, -oldT
+	}
+	return oldR, oldS, oldT
+}
+
+// ErrNoInverse is returned by ModInverse if a and m are not coprime.
+var ErrNoInverse = errors.New("no modular inverse")
+
+// ModInverse returns x in [0, m) with a*x ≡ 1 (mod m). m must be positive.
+func ModInverse[T Signed](a, m T) (T, error) {
+	g, x, _ := ExtendedGCD(Mod(a, m), m)
+	if g != 1 {
+		return 0, fmt.Errorf("%d mod %d: %w", a, m, ErrNoInverse)
+	}
+	return Mod(x, m), nil
+}
+
+// Mod returns a modulo m in [0, m), unlike the % operator, whose result has
+// the sign of a. m must be positive.
+func Mod[T Integer](a, m T) T {
+	r := a % m
+	if r < 0 {
+		r += m
+	}
+	return r
+}
+
+// mulMod returns a*b mod m without overflow.
+func mulMod(a, b, m uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	return bits.Rem64(hi, lo, m)
+}
+
+// ModPow returns base^exp mod m in [0, m) without intermediate overflow.
+// It returns an error if m is not positive or exp is negative.
+func ModPow[T Integer](base, exp, m T) (T, error) {
+	if m <= 0 {
+		return 0, fmt.Errorf("ModPow: modulus %d is not positive", m)
+	}
+	if exp < 0 {
+		return 0, fmt.Errorf("ModPow: exponent %d is negative", exp)
+	}
+	b, e, mod := uint64(Mod(base, m)), uint64(exp), uint64(m)
+	result := uint64(1) % mod
+	for e > 0 {
+		if e&1 == 1 {
+			result = mulMod(result, b, mod)
+		}
+		b = mulMod(b, b, mod)
+		e >>= 1
+	}
+	return T(result), nil
+}
+
+// MustModPow is ModPow, but panics instead of returning an error.
+func MustModPow[T Integer](base, exp, m T) T {
+	r, err := ModPow(base, exp, m)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// ErrNoSolution is returned by CRT if the congruences contradict each other.
+var ErrNoSolution = errors.New("no solution")
+
+// CRT solves the system of congruences x ≡ residues[i] (mod moduli[i]) using
+// the Chinese Remainder Theorem. The moduli must be positive, but need not be
+// coprime. It returns the smallest non-negative solution x and the modulus m
+// of the combined congruence, i. e. the least common multiple of all moduli.
+// It returns ErrNoSolution if the congruences are incompatible and ErrOverflow
+// if m doesn't fit into T.
+func CRT[T Signed](residues, moduli []T) (x, m T, err error) {
+	if len(residues) != len(moduli) {
+		return 0, 0, fmt.Errorf("got %d residues, but %d moduli", len(residues), len(moduli))
+	}
+
+	// Intermediate products may overflow T, so compute with big.Int.
+	bx, bm := big.NewInt(0), big.NewInt(1)
+	for i := range moduli {
+		if moduli[i] <= 0 {
+			return 0, 0, fmt.Errorf("modulus %d is not positive", moduli[i])
+		}
+		a, n := big.NewInt(int64(residues[i])), big.NewInt(int64(moduli[i]))
+		a.Mod(a, n)
+
+		// Solve bx + bm*k ≡ a (mod n) for k.
+		g, inv := new(big.Int), new(big.Int)
+		g.GCD(inv, nil, bm, n)
+		diff := new(big.Int).Sub(a, bx)
+		if new(big.Int).Mod(diff, g).Sign() != 0 {
+			return 0, 0, fmt.Errorf("x ≡ %d (mod %d): %w", residues[i], moduli[i], ErrNoSolution)
+		}
+		ng := new(big.Int).Quo(n, g)
+		k := diff.Quo(diff, g)
+		k.Mul(k, inv).Mod(k, ng)
+
+		bx.Add(bx, k.Mul(k, bm))
+		bm.Mul(bm, ng)
+		bx.Mod(bx, bm)
+	}
+
+	if !bm.IsInt64() || int64(T(bm.Int64())) != bm.Int64() {
+		return 0, 0, fmt.Errorf("combined modulus %v: %w", bm, ErrOverflow)
+	}
+	return T(bx.Int64()), T(bm.Int64()), nil
+}
+
+// A PrimePower is a factor Prime^Exp of a prime factorisation.
+type PrimePower[T Integer] struct {
+	Prime T
+	Exp   int
+}
+
+// Factorize returns the prime factorisation of n > 1 in ascending order of
+// primes, using trial division. It returns nil for n < 2.
+func Factorize[T Integer](n T) []PrimePower[T] {
+	var result []PrimePower[T]
+	for p := T(2); p <= n/p; p++ {
+		if n%p != 0 {
+			continue
+		}
+		pp := PrimePower[T]{Prime: p}
+		for n%p == 0 {
+			n /= p
+			pp.Exp++
+		}
+		result = append(result, pp)
+	}
+	if n > 1 {
+		result = append(result, PrimePower[T]{Prime: n, Exp: 1})
+	}
+	return result
+}
+
+// Divisors returns all positive divisors of n > 0 in ascending order.
+func Divisors[T Integer](n T) []T {
+	if n <= 0 {
+		return nil
+	}
+	divisors := []T{1}
+	for _, pp := range Factorize(n) {
+		current := len(divisors)
+		power := T(1)
+		for e := 0; e < pp.Exp; e++ {
+			power *= pp.Prime
+			for _, d := range divisors[:current] {
+				divisors = append(divisors, d*power)
+			}
+		}
+	}
+	slices.Sort(divisors)
+	return divisors
+}
@@ -0,0 +1,139 @@
+package integer
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"reflect"
+	"testing"
+)
+
+func TestGCDLCM(t *testing.T) {
+	if got := GCD(-12, 18); got != 6 {
+		t.Errorf("GCD(-12, 18) = %d, want 6", got)
+	}
+	for _, tt := range []struct{ a, b, want int64 }{
+		{math.MinInt64, 6, 2},
+		{6, math.MinInt64, 2},
+		{math.MinInt64, -1, 1},
+		{math.MinInt64, 1 << 62, 1 << 62},
+		{math.MinInt64, 0, math.MinInt64},
+	} {
+		if got := GCD(tt.a, tt.b); got != tt.want {
+			t.Errorf("GCD(%d, %d) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+	if got := GCDAll[uint](12, 18, 27); got != 3 {
+		t.Errorf("GCDAll(12, 18, 27) = %d, want 3", got)
+	}
+	got, err := LCMAll(2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20)
+	if err != nil || got != 232792560 {
+		t.Errorf("LCMAll(2..20) = %d, %v, want 232792560", got, err)
+	}
+	if _, err := LCMAll[int32](65536, 65537); !errors.Is(err, ErrOverflow) {
+		t.Errorf("LCMAll[int32]() error = %v, want ErrOverflow", err)
+	}
+	if _, err := LCM[int64](math.MinInt64, -1); !errors.Is(err, ErrOverflow) {
+		t.Errorf("LCM(MinInt64, -1) error = %v, want ErrOverflow", err)
+	}
+}
+
+func TestExtendedGCD(t *testing.T) {
+	for _, tt := range [][2]int{{240, 46}, {-240, 46}, {17, 5}, {0, 7}, {7, 0}} {
+		a, b := tt[0], tt[1]
+		g, x, y := ExtendedGCD(a, b)
+		if g != GCD(a, b) || a*x+b*y != g {
+			t.Errorf("ExtendedGCD(%d, %d) = %d, %d, %d", a, b, g, x, y)
+		}
+	}
+}
+
+func TestModular(t *testing.T) {
+	if got, err := ModInverse(3, 11); err != nil || got != 4 {
+		t.Errorf("ModInverse(3, 11) = %d, %v, want 4", got, err)
+	}
+	if got, err := ModInverse(-3, 11); err != nil || got != 7 {
+		t.Errorf("ModInverse(-3, 11) = %d, %v, want 7", got, err)
+	}
+	if _, err := ModInverse(6, 9); !errors.Is(err, ErrNoInverse) {
+		t.Errorf("ModInverse(6, 9) error = %v, want ErrNoInverse", err)
+	}
+	if got := Mod(-7, 3); got != 2 {
+		t.Errorf("Mod(-7, 3) = %d, want 2", got)
+	}
+	if got, err := ModPow(2, 10, 1000); err != nil || got != 24 {
+		t.Errorf("ModPow(2, 10, 1000) = %d, %v, want 24", got, err)
+	}
+	// the intermediate products overflow uint64
+	const m = math.MaxUint64 - 58
+	want := new(big.Int).Exp(big.NewInt(1<<62), big.NewInt(12345), new(big.Int).SetUint64(m))
+	if got := MustModPow[uint64](1<<62, 12345, m); got != want.Uint64() {
+		t.Errorf("ModPow() = %d, want %v", got, want)
+	}
+	if got := MustModPow(-2, 3, 5); got != 2 {
+		t.Errorf("ModPow(-2, 3, 5) = %d, want 2", got)
+	}
+	if _, err := ModPow(2, 3, 0); err == nil {
+		t.Error("ModPow(2, 3, 0): expected error for zero modulus")
+	}
+	if _, err := ModPow(2, -1, 5); err == nil {
+		t.Error("ModPow(2, -1, 5): expected error for negative exponent")
+	}
+}
+
+func TestCRT(t *testing.T) {
+	tests := []struct {
+		name      string
+		residues  []int64
+		moduli    []int64
+		x, m      int64
+		wantError error
+	}{
+		{"coprime", []int64{2, 3, 2}, []int64{3, 5, 7}, 23, 105, nil},
+		{"non-coprime", []int64{3, 5}, []int64{4, 6}, 11, 12, nil},
+		{"negative residue", []int64{-1, 0}, []int64{5, 3}, 9, 15, nil},
+		{"contradiction", []int64{1, 2}, []int64{4, 6}, 0, 0, ErrNoSolution},
+		{"large", []int64{0, 1}, []int64{3037000493, 3037000453}, 691752890551091762, 3037000493 * 3037000453, nil},
+		{"overflow", []int64{0, 0}, []int64{math.MaxInt64, math.MaxInt64 - 1}, 0, 0, ErrOverflow},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			x, m, err := CRT(tt.residues, tt.moduli)
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("CRT() error = %v, want %v", err, tt.wantError)
+			}
+			if err != nil {
+				return
+			}
+			if x != tt.x || m != tt.m {
+				t.Errorf("CRT() = %d, %d, want %d, %d", x, m, tt.x, tt.m)
+			}
+			for i := range tt.moduli {
+				if Mod(x-tt.residues[i], tt.moduli[i]) != 0 {
+					t.Errorf("CRT() x = %d doesn't satisfy congruence %d", x, i)
+				}
+			}
+			if x < 0 || x >= m {
+				t.Errorf("CRT() x = %d not in [0, %d)", x, m)
+			}
+		})
+	}
+}
+
+func TestFactorize(t *testing.T) {
+	if got, want := Factorize(360), []PrimePower[int]{{2, 3}, {3, 2}, {5, 1}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Factorize(360) = %v, want %v", got, want)
+	}
+	if got, want := Factorize[uint64](600851475143), []PrimePower[uint64]{{71, 1}, {839, 1}, {1471, 1}, {6857, 1}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Factorize(600851475143) = %v, want %v", got, want)
+	}
+	if got := Factorize(1); got != nil {
+		t.Errorf("Factorize(1) = %v, want nil", got)
+	}
+	if got, want := Divisors(28), []int{1, 2, 4, 7, 14, 28}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Divisors(28) = %v, want %v", got, want)
+	}
+	if got := len(Divisors(720720)); got != 240 {
+		t.Errorf("len(Divisors(720720)) = %d, want 240", got)
+	}
+}
@@ -0,0 +1,271 @@
+// Package interval provides half-open integer intervals, sets of intervals
+// and piecewise offset maps, as needed for range-mapping puzzles.
+package interval
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// An Interval is the half-open range [Lo, Hi). It is empty if Hi <= Lo.
+type Interval struct {
+	Lo, Hi int64
+}
+
+// New is a convenience constructor for Interval.
+func New(lo, hi int64) Interval {
+	return Interval{Lo: lo, Hi: hi}
+}
+
+// Len is a convenience constructor for the interval of length n starting at lo.
+func Len(lo, n int64) Interval {
+	return Interval{Lo: lo, Hi: lo + n}
+}
+
+func (i Interval) String() string {
+	return fmt.Sprintf("[%d,%d)", i.Lo, i.Hi)
+}
+
+// Empty returns true if i contains no values.
+func (i Interval) Empty() bool {
+	return i.Hi <= i.Lo
+}
+
+// Len returns the number of values in i.
+func (i Interval) Len() int64 {
+	if i.Empty() {
+		return 0
+	}
+	return i.Hi - i.Lo
+}
+
+// Contains returns true if x is in i.
+func (i Interval) Contains(x int64) bool {
+	return i.Lo <= x && x < i.Hi
+}
+
+// Intersect returns the values in both i and j. If they don't overlap, the
+// result is the empty interval Interval{}.
+func (i Interval) Intersect(j Interval) Interval {
+	r := Interval{Lo: max(i.Lo, j.Lo), Hi: min(i.Hi, j.Hi)}
+	if r.Empty() {
+		return Interval{}
+	}
+	return r
+}
+
+// Union returns the values in i or j as a single interval. It returns false
+// if they are neither overlapping nor adjacent, in which case a Set is needed.
+func (i Interval) Union(j Interval) (Interval, bool) {
+	switch {
+	case i.Empty():
+		return j, true
+	case j.Empty():
+		return i, true
+	case i.Hi < j.Lo || j.Hi < i.Lo:
+		return Interval{}, false
+	}
+	return Interval{Lo: min(i.Lo, j.Lo), Hi: max(i.Hi, j.Hi)}, true
+}
+
+// Subtract returns the values in i, but not in j, as up to two non-empty
+// intervals in ascending order.
+func (i Interval) Subtract(j Interval) []Interval {
+	if i.Empty() {
+		return nil
+	}
+	if i.Intersect(j).Empty() {
+		return []Interval{i}
+	}
+	var result []Interval
+	if left := New(i.Lo, j.Lo); !left.Empty() {
+		result = append(result, left)
+	}
+	if right := New(j.Hi, i.Hi); !right.Empty() {
+		result = append(result, right)
+	}
+	return result
+}
+
+// SplitAt splits i into the values below x and the values from x on.
+// Either part may be empty.
+func (i Interval) SplitAt(x int64) (Interval, Interval) {
+	x = min(max(x, i.Lo), i.Hi)
+	return New(i.Lo, x), New(x, i.Hi)
+}
+
+// A Set is a set of values represented by sorted, disjoint, non-adjacent and
+// non-empty intervals. The zero value is the empty set.
+type Set struct {
+	intervals []Interval
+}
+
+// NewSet returns the set of all values in any of the given intervals.
+func NewSet(intervals ...Interval) Set {
+	sorted := make([]Interval, 0, len(intervals))
+	for _, i := range intervals {
+		if !i.Empty() {
+			sorted = append(sorted, i)
+		}
+	}
+	slices.SortFunc(sorted, func(a, b Interval) int {
+		switch {
+		case a.Lo < b.Lo:
+			return -1
+		case a.Lo > b.Lo:
+			return 1
+		}
+		return 0
+	})
+
+	var s Set
+	for _, i := range sorted {
+		if n := len(s.intervals); n > 0 {
+			if u, ok := s.intervals[n-1].Union(i); ok {
+				s.intervals[n-1] = u
+				continue
+			}
+		}
+		s.intervals = append(s.intervals, i)
+	}
+	return s
+}
+
+// Intervals returns the normalised intervals of s in ascending order.
+func (s Set) Intervals() []Interval {
+	return slices.Clone(s.intervals)
+}
+
+func (s Set) String() string {
+	parts := make([]string, len(s.intervals))
+	for i, iv := range s.intervals {
+		parts[i] = iv.String()
+	}
+	return "{" + strings.Join(parts, " ") + "}"
+}
+
+// Len returns the number of values in s.
+func (s Set) Len() int64 {
+	var n int64
+	for _, i := range s.intervals {
+		n += i.Len()
+	}
+	return n
+}
+
+// Empty returns true if s contains no values.
+func (s Set) Empty() bool {
+	return len(s.intervals) == 0
+}
+
+// Contains returns true if x is in s.
+func (s Set) Contains(x int64) bool {
+	idx, _ := slices.BinarySearchFunc(s.intervals, x, func(i Interval, x int64) int {
+		switch {
+		case i.Hi <= x:
+			return -1
+		case i.Lo > x:
+			return 1
+		}
+		return 0
+	})
+	return idx < len(s.intervals) && s.intervals[idx].Contains(x)
+}
+
+// Min returns the smallest value in s. It returns false if s is empty.
+func (s Set) Min() (int64, bool) {
+	if s.Empty() {
+		return 0, false
+	}
+	return s.intervals[0].Lo, true
+}
+
+// Union returns the values in s or t.
+func (s Set) Union(t Set) Set {
+	return NewSet(append(s.Intervals(), t.intervals...)...)
+}
+
+// Intersect returns the values in both s and t.
+func (s Set) Intersect(t Set) Set {
+	var result []Interval
+	for i, j := 0, 0; i < len(s.intervals) && j < len(t.intervals); {
+		a, b := s.intervals[i], t.intervals[j]
+		if x := a.Intersect(b); !x.Empty() {
+			result = append(result, x)
+		}
+		if a.Hi < b.Hi {
+			i++
+		} else {
+			j++
+		}
+	}
+	return Set{intervals: result}
+}
+
+// Subtract returns the values in s, but not in t.
+func (s Set) Subtract(t Set) Set {
+	var result []Interval
+	for _, a := range s.intervals {
+		rest := []Interval{a}
+		for _, b := range t.intervals {
+			if b.Lo >= a.Hi {
+				break
+			}
+			var next []Interval
+			for _, r := range rest {
+				next = append(next, r.Subtract(b)...)
+			}
+			rest = next
+		}
+		result = append(result, rest...)
+	}
+	return Set{intervals: result}
+}
+
+// A Map is a piecewise offset map: values in the source interval of an entry
+// are shifted by its offset, all other values are mapped to themselves.
+type Map struct {
+	entries []mapEntry
+}
+
+type mapEntry struct {
+	src    Interval
+	offset int64
+}
+
+// Add adds an entry shifting the values in src by offset. Values in src that
+// are already covered by a previous entry are not affected.
+func (m *Map) Add(src Interval, offset int64) {
+	m.entries = append(m.entries, mapEntry{src: src, offset: offset})
+}
+
+// AddRange adds an entry in the format of the almanac maps: n values starting
+// at src are mapped to n values starting at dst.
+func (m *Map) AddRange(dst, src, n int64) {
+	m.Add(Len(src, n), dst-src)
+}
+
+// Apply maps a single value.
+func (m Map) Apply(x int64) int64 {
+	for _, e := range m.entries {
+		if e.src.Contains(x) {
+			return x + e.offset
+		}
+	}
+	return x
+}
+
+// ApplySet maps all values in s at once.
+func (m Map) ApplySet(s Set) Set {
+	var result []Interval
+	rest := s
+	for _, e := range m.entries {
+		hit := rest.Intersect(NewSet(e.src))
+		for _, i := range hit.intervals {
+			result = append(result, New(i.Lo+e.offset, i.Hi+e.offset))
+		}
+		rest = rest.Subtract(hit)
+	}
+	return NewSet(append(result, rest.intervals...)...)
+}
@@ -0,0 +1,182 @@
+package interval
+
+import (
+	"testing"
+)
+
+// The brute-force reference represents sets of values in [lo, hi) as bitmasks.
+const (
+	lo = -3
+	hi = 9
+)
+
+func mask(i Interval) uint64 {
+	var m uint64
+	for x := int64(lo); x < hi; x++ {
+		if i.Contains(x) {
+			m |= 1 << (x - lo)
+		}
+	}
+	return m
+}
+
+func setMask(s Set) uint64 {
+	var m uint64
+	for x := int64(lo); x < hi; x++ {
+		if s.Contains(x) {
+			m |= 1 << (x - lo)
+		}
+	}
+	return m
+}
+
+func normalised(t *testing.T, s Set) {
+	t.Helper()
+	for k, i := range s.intervals {
+		if i.Empty() {
+			t.Fatalf("%v contains empty interval", s)
+		}
+		if k > 0 && s.intervals[k-1].Hi >= i.Lo {
+			t.Fatalf("%v is not normalised", s)
+		}
+	}
+}
+
+// intervals returns all intervals with bounds in [from, to], including
+// empty ones.
+func intervals(from, to int64) []Interval {
+	var result []Interval
+	for a := from; a <= to; a++ {
+		for b := from; b <= to; b++ {
+			result = append(result, New(a, b))
+		}
+	}
+	return result
+}
+
+func TestInterval(t *testing.T) {
+	all := intervals(-2, 6)
+	for _, i := range all {
+		if got, want := i.Len(), int64(popcount(mask(i))); got != want {
+			t.Errorf("%v.Len() = %d, want %d", i, got, want)
+		}
+
+		for x := int64(-3); x <= 7; x++ {
+			a, b := i.SplitAt(x)
+			if mask(a)|mask(b) != mask(i) || mask(a)&mask(b) != 0 || (!a.Empty() && a.Hi > x) || (!b.Empty() && b.Lo < x) {
+				t.Errorf("%v.SplitAt(%d) = %v, %v", i, x, a, b)
+			}
+		}
+
+		for _, j := range all {
+			if got, want := mask(i.Intersect(j)), mask(i)&mask(j); got != want {
+				t.Errorf("%v.Intersect(%v) = %v", i, j, i.Intersect(j))
+			}
+
+			u, ok := i.Union(j)
+			if ok && mask(u) != mask(i)|mask(j) {
+				t.Errorf("%v.Union(%v) = %v", i, j, u)
+			}
+			if !ok && mask(i)|mask(j) == mask(New(min(i.Lo, j.Lo), max(i.Hi, j.Hi))) {
+				t.Errorf("%v.Union(%v) failed, but union is an interval", i, j)
+			}
+
+			var sub uint64
+			for _, s := range i.Subtract(j) {
+				if s.Empty() {
+					t.Errorf("%v.Subtract(%v) contains empty interval", i, j)
+				}
+				sub |= mask(s)
+			}
+			if want := mask(i) &^ mask(j); sub != want {
+				t.Errorf("%v.Subtract(%v) = %v", i, j, i.Subtract(j))
+			}
+		}
+	}
+}
+
+func popcount(m uint64) int {
+	n := 0
+	for ; m != 0; m &= m - 1 {
+		n++
+	}
+	return n
+}
+
+func TestSet(t *testing.T) {
+	base := intervals(0, 4)
+	var sets []Set
+	for _, a := range base {
+		for _, b := range base {
+			sets = append(sets, NewSet(a, New(b.Lo+3, b.Hi+3)))
+		}
+	}
+
+	for _, s := range sets {
+		normalised(t, s)
+		if got, want := s.Len(), int64(popcount(setMask(s))); got != want {
+			t.Errorf("%v.Len() = %d, want %d", s, got, want)
+		}
+	}
+
+	for _, s := range sets {
+		for _, u := range sets {
+			ms, mu := setMask(s), setMask(u)
+			tests := []struct {
+				name string
+				got  Set
+				want uint64
+			}{
+				{"Union", s.Union(u), ms | mu},
+				{"Intersect", s.Intersect(u), ms & mu},
+				{"Subtract", s.Subtract(u), ms &^ mu},
+			}
+			for _, tt := range tests {
+				normalised(t, tt.got)
+				if setMask(tt.got) != tt.want {
+					t.Fatalf("%v.%s(%v) = %v", s, tt.name, u, tt.got)
+				}
+			}
+		}
+	}
+}
+
+func TestMap(t *testing.T) {
+	var m Map
+	m.AddRange(50, 98, 2)
+	m.AddRange(52, 50, 48)
+	for seed, want := range map[int64]int64{79: 81, 14: 14, 55: 57, 13: 13, 98: 50, 99: 51, 100: 100} {
+		if got := m.Apply(seed); got != want {
+			t.Errorf("Map.Apply(%d) = %d, want %d", seed, got, want)
+		}
+	}
+
+	got := m.ApplySet(NewSet(Len(79, 14), Len(55, 13)))
+	if want := NewSet(Len(81, 14), Len(57, 13)); got.String() != want.String() {
+		t.Errorf("Map.ApplySet() = %v, want %v", got, want)
+	}
+
+	// compare ApplySet to Apply for small maps and sets
+	entries := intervals(0, 4)
+	for _, a := range entries {
+		for _, b := range entries {
+			var m Map
+			m.Add(a, 2)
+			m.Add(b, -1)
+			for _, s := range entries {
+				set := NewSet(s)
+				var want uint64
+				for x := int64(lo); x < hi; x++ {
+					if set.Contains(x) {
+						want |= 1 << (m.Apply(x) - lo)
+					}
+				}
+				mapped := m.ApplySet(set)
+				normalised(t, mapped)
+				if got := setMask(mapped); got != want {
+					t.Fatalf("map %v applied to %v = %v", m, set, mapped)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,245 @@
+// Package linalg provides exact linear algebra on rational numbers, for
+// puzzles whose inputs are small, but whose products overflow int64.
+package linalg
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// A Vector is a vector of exact rational numbers.
+type Vector []*big.Rat
+
+// Ints converts a slice of integers, such as returned by parse.IntListSep,
+// to a Vector.
+func Ints(ns []int) Vector {
+	v := make(Vector, len(ns))
+	for i, n := range ns {
+		v[i] = new(big.Rat).SetInt64(int64(n))
+	}
+	return v
+}
+
+func (v Vector) String() string {
+	parts := make([]string, len(v))
+	for i, x := range v {
+		parts[i] = x.RatString()
+	}
+	return "(" + strings.Join(parts, ", ") + ")"
+}
+
+// Equal returns true if v and w have the same dimension and entries.
+func (v Vector) Equal(w Vector) bool {
+	if len(v) != len(w) {
+		return false
+	}
+	for i := range v {
+		if v[i].Cmp(w[i]) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Add returns v+w.
+func (v Vector) Add(w Vector) Vector {
+	r := make(Vector, len(v))
+	for i := range v {
+		r[i] = new(big.Rat).Add(v[i], w[i])
+	}
+	return r
+}
+
+// Sub returns v-w.
+func (v Vector) Sub(w Vector) Vector {
+	r := make(Vector, len(v))
+	for i := range v {
+		r[i] = new(big.Rat).Sub(v[i], w[i])
+	}
+	return r
+}
+
+// Scale returns k*v.
+func (v Vector) Scale(k *big.Rat) Vector {
+	r := make(Vector, len(v))
+	for i := range v {
+		r[i] = new(big.Rat).Mul(v[i], k)
+	}
+	return r
+}
+
+// A Matrix is a matrix of exact rational numbers, stored as a slice of rows.
+type Matrix []Vector
+
+// IntMatrix converts rows of integers to a Matrix.
+func IntMatrix(rows [][]int) Matrix {
+	m := make(Matrix, len(rows))
+	for i, row := range rows {
+		m[i] = Ints(row)
+	}
+	return m
+}
+
+// ErrSingular is returned by Solve if the system has no unique solution.
+var ErrSingular = errors.New("matrix is singular")
+
+// Solve solves the N×N system a*x = b by Gaussian elimination and returns x.
+// a and b are not modified. It returns ErrSingular if the system has no
+// unique solution.
+func Solve(a Matrix, b Vector) (Vector, error) {
+	n := len(a)
+	if len(b) != n {
+		return nil, fmt.Errorf("matrix has %d rows, but vector has %d entries", n, len(b))
+	}
+
+	// augmented copy of a | b
+	m := make(Matrix, n)
+	for i, row := range a {
+		if len(row) != n {
+			return nil, fmt.Errorf("row %d has %d entries, want %d", i, len(row), n)
+		}
+		m[i] = make(Vector, n+1)
+		for j, x := range row {
+			m[i][j] = new(big.Rat).Set(x)
+		}
+		m[i][n] = new(big.Rat).Set(b[i])
+	}
+
+	for col := 0; col < n; col++ {
+		pivot := -1
+		for row := col; row < n; row++ {
+			if m[row][col].Sign() != 0 {
+				pivot = row
+				break
+			}
+		}
+		if pivot < 0 {
+			return nil, ErrSingular
+		}
+		m[col], m[pivot] = m[pivot], m[col]
+
+		for row := 0; row < n; row++ {
+			if row == col || m[row][col].Sign() == 0 {
+				continue
+			}
+			f := new(big.Rat).Quo(m[row][col], m[col][col])
+			for j := col; j <= n; j++ {
+				m[row][j].Sub(m[row][j], new(big.Rat).Mul(f, m[col][j]))
+			}
+		}
+	}
+
+	x := make(Vector, n)
+	for i := range x {
+		x[i] = new(big.Rat).Quo(m[i][n], m[i][i])
+	}
+	return x, nil
+}
+
+// A Line is the set of points P + t*D for all t. It may have any dimension,
+// usually 2 or 3.
+type Line struct {
+	P, D Vector
+}
+
+// IntLine is a convenience constructor for a line through p with direction d,
+// e. g. the position and velocity of a hailstone.
+func IntLine(p, d []int) Line {
+	return Line{P: Ints(p), D: Ints(d)}
+}
+
+// At returns the point P + t*D.
+func (l Line) At(t *big.Rat) Vector {
+	return l.P.Add(l.D.Scale(t))
+}
+
+// A Relation describes how two lines relate to each other.
+type Relation int
+
+const (
+	// Intersecting lines meet in exactly one point.
+	Intersecting Relation = iota
+	// Parallel lines have the same direction, but no common point.
+	Parallel
+	// Colinear lines are identical.
+	Colinear
+	// Skew lines are neither parallel nor intersecting, which is only
+	// possible in three or more dimensions.
+	Skew
+)
+
+func (r Relation) String() string {
+	switch r {
+	case Intersecting:
+		return "intersecting"
+	case Parallel:
+		return "parallel"
+	case Colinear:
+		return "colinear"
+	case Skew:
+		return "skew"
+	}
+	return fmt.Sprintf("Relation(%d)", int(r))
+}
+
+// An Intersection is the common point of two lines a and b, with
+// Point = a.At(T) = b.At(S).
+type Intersection struct {
+	Point Vector
+	T, S  *big.Rat
+}
+
+// minor returns v[i]*w[j] - v[j]*w[i].
+func minor(v, w Vector, i, j int) *big.Rat {
+	return new(big.Rat).Sub(new(big.Rat).Mul(v[i], w[j]), new(big.Rat).Mul(v[j], w[i]))
+}
+
+// allMinorsZero returns true if v and w are linearly dependent.
+func allMinorsZero(v, w Vector) bool {
+	for i := range v {
+		for j := i + 1; j < len(v); j++ {
+			if minor(v, w, i, j).Sign() != 0 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Intersect determines the relation of a and b, which must have the same
+// dimension, and their intersection if they are Intersecting.
+func Intersect(a, b Line) (Intersection, Relation, error) {
+	if len(a.P) != len(a.D) || len(b.P) != len(b.D) || len(a.P) != len(b.P) {
+		return Intersection{}, 0, errors.New("dimension mismatch")
+	}
+
+	w := b.P.Sub(a.P)
+	if allMinorsZero(a.D, b.D) {
+		if allMinorsZero(a.D, w) {
+			return Intersection{}, Colinear, nil
+		}
+		return Intersection{}, Parallel, nil
+	}
+
+	// Solve a.P + t*a.D = b.P + s*b.D in two coordinates i, j in which the
+	// directions are independent, by Cramer's rule.
+	for i := range a.D {
+		for j := i + 1; j < len(a.D); j++ {
+			det := minor(a.D, b.D, i, j)
+			if det.Sign() == 0 {
+				continue
+			}
+			t := new(big.Rat).Quo(minor(w, b.D, i, j), det)
+			s := new(big.Rat).Quo(minor(w, a.D, i, j), det)
+			p := a.At(t)
+			if !p.Equal(b.At(s)) {
+				return Intersection{}, Skew, nil
+			}
+			return Intersection{Point: p, T: t, S: s}, Intersecting, nil
+		}
+	}
+
+	panic("unreachable: directions are independent, but all minors are zero")
+}
@@ -0,0 +1,101 @@
+package linalg
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/Xjs/aoc2023/parse"
+)
+
+func hailstone(t *testing.T, pos, vel string, dims int) Line {
+	t.Helper()
+	p, err := parse.IntListSep(pos, ",")
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, err := parse.IntListSep(vel, ",")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return IntLine(p[:dims], v[:dims])
+}
+
+func TestIntersect(t *testing.T) {
+	a := hailstone(t, "19, 13, 30", "-2, 1, -2", 2)
+	b := hailstone(t, "18, 19, 22", "-1, -1, -2", 2)
+	c := hailstone(t, "20, 25, 34", "-2, -2, -4", 2)
+
+	x, rel, err := Intersect(a, b)
+	if err != nil || rel != Intersecting {
+		t.Fatalf("Intersect(a, b) = %v, %v, %v", x, rel, err)
+	}
+	if want := (Vector{big.NewRat(43, 3), big.NewRat(46, 3)}); !x.Point.Equal(want) {
+		t.Errorf("Intersect(a, b) = %v, want %v", x.Point, want)
+	}
+	if x.T.Sign() < 0 || x.S.Sign() < 0 {
+		t.Errorf("Intersect(a, b) lies in the past: t = %v, s = %v", x.T, x.S)
+	}
+
+	if _, rel, _ := Intersect(b, c); rel != Parallel {
+		t.Errorf("Intersect(b, c) = %v, want parallel", rel)
+	}
+	same := IntLine([]int{20, 21}, []int{-2, -2})
+	if _, rel, _ := Intersect(b, same); rel != Colinear {
+		t.Errorf("Intersect(b, same) = %v, want colinear", rel)
+	}
+
+	// three dimensions, with products beyond int64
+	big1 := hailstone(t, "0, 0, 0", "3000000000, 3000000000, 3000000000", 3)
+	big2 := hailstone(t, "6000000000000000000, 0, 6000000000000000000", "0, 3000000000, 0", 3)
+	x, rel, err = Intersect(big1, big2)
+	if err != nil || rel != Intersecting {
+		t.Fatalf("Intersect(big1, big2) = %v, %v, %v", x, rel, err)
+	}
+	if want := Ints([]int{6000000000000000000, 6000000000000000000, 6000000000000000000}); !x.Point.Equal(want) {
+		t.Errorf("Intersect(big1, big2) = %v, want %v", x.Point, want)
+	}
+
+	skew1 := IntLine([]int{0, 0, 0}, []int{1, 0, 0})
+	skew2 := IntLine([]int{0, 1, 1}, []int{0, 1, 0})
+	if _, rel, _ := Intersect(skew1, skew2); rel != Skew {
+		t.Errorf("Intersect(skew1, skew2) = %v, want skew", rel)
+	}
+
+	if _, _, err := Intersect(a, skew1); err == nil {
+		t.Error("expected error for dimension mismatch")
+	}
+}
+
+func TestSolve(t *testing.T) {
+	a := IntMatrix([][]int{
+		{0, 2, 1},
+		{1, -2, -3},
+		{-1, 1, 2},
+	})
+	b := Ints([]int{-8, 0, 3})
+	x, err := Solve(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := Ints([]int{-4, -5, 2}); !x.Equal(want) {
+		t.Errorf("Solve() = %v, want %v", x, want)
+	}
+
+	large := IntMatrix([][]int{
+		{4000000000000000000, 1},
+		{1, 4000000000000000000},
+	})
+	x, err = Solve(large, Ints([]int{4000000000000000001, 4000000000000000001}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := Ints([]int{1, 1}); !x.Equal(want) {
+		t.Errorf("Solve() = %v, want %v", x, want)
+	}
+
+	singular := IntMatrix([][]int{{1, 2}, {2, 4}})
+	if _, err := Solve(singular, Ints([]int{1, 2})); !errors.Is(err, ErrSingular) {
+		t.Errorf("Solve() error = %v, want ErrSingular", err)
+	}
+}
@@ -0,0 +1,243 @@
+// Package comb provides parser combinators for inputs that are small
+// grammars, such as nested lists or rule definitions.
+//
+// A Parser reads a value from its input at a given position and returns the
+// position after the value. Errors are *parse.Error values carrying the
+// column at which parsing failed.
+package comb
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/Xjs/aoc2023/parse"
+)
+
+// A Parser parses a T from in, starting at byte offset pos.
+// It returns the value and the offset after it.
+type Parser[T any] func(in string, pos int) (T, int, error)
+
+// fail returns a *parse.Error for a failure at pos.
+func fail(in string, pos int, format string, args ...any) error {
+	return &parse.Error{Column: pos + 1, Input: in, Err: fmt.Errorf(format, args...)}
+}
+
+// column returns the column of a *parse.Error, or 0 for other errors.
+func column(err error) int {
+	if pe, ok := err.(*parse.Error); ok {
+		return pe.Column
+	}
+	return 0
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+func isLetter(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
+
+// Int parses a decimal integer with an optional leading '+' or '-' sign.
+func Int() Parser[int] {
+	return func(in string, pos int) (int, int, error) {
+		end := pos
+		if end < len(in) && (in[end] == '+' || in[end] == '-') {
+			end++
+		}
+		start := end
+		for end < len(in) && isDigit(in[end]) {
+			end++
+		}
+		if end == start {
+			return 0, pos, fail(in, pos, "expected integer")
+		}
+		n, err := strconv.Atoi(in[pos:end])
+		if err != nil {
+			return 0, pos, &parse.Error{Column: pos + 1, Input: in, Err: err}
+		}
+		return n, end, nil
+	}
+}
+
+// Word parses a non-empty run of ASCII letters.
+func Word() Parser[string] {
+	return func(in string, pos int) (string, int, error) {
+		end := pos
+		for end < len(in) && isLetter(in[end]) {
+			end++
+		}
+		if end == pos {
+			return "", pos, fail(in, pos, "expected word")
+		}
+		return in[pos:end], end, nil
+	}
+}
+
+// Literal parses exactly s.
+func Literal(s string) Parser[string] {
+	return func(in string, pos int) (string, int, error) {
+		if !strings.HasPrefix(in[pos:], s) {
+			return "", pos, fail(in, pos, "expected %q", s)
+		}
+		return s, pos + len(s), nil
+	}
+}
+
+// Many parses p as often as possible, possibly zero times.
+func Many[T any](p Parser[T]) Parser[[]T] {
+	return func(in string, pos int) ([]T, int, error) {
+		var result []T
+		for {
+			v, next, err := p(in, pos)
+			if err != nil || next == pos {
+				return result, pos, nil
+			}
+			result = append(result, v)
+			pos = next
+		}
+	}
+}
+
+// Sep parses one or more ps, separated by sep.
+func Sep[T any](p Parser[T], sep string) Parser[[]T] {
+	return func(in string, pos int) ([]T, int, error) {
+		v, pos, err := p(in, pos)
+		if err != nil {
+			return nil, pos, err
+		}
+		result := []T{v}
+		for strings.HasPrefix(in[pos:], sep) {
+			v, next, err := p(in, pos+len(sep))
+			if err != nil {
+				return nil, pos, err
+			}
+			result = append(result, v)
+			pos = next
+		}
+		return result, pos, nil
+	}
+}
+
+// Optional parses p if possible. The result is nil if p failed, in which case
+// no input is consumed.
+func Optional[T any](p Parser[T]) Parser[*T] {
+	return func(in string, pos int) (*T, int, error) {
+		v, next, err := p(in, pos)
+		if err != nil {
+			return nil, pos, nil
+		}
+		return &v, next, nil
+	}
+}
+
+// Choice tries each of ps in turn and returns the result of the first one
+// that succeeds. If all of them fail, it returns the error of the parser that
+// got furthest.
+func Choice[T any](ps ...Parser[T]) Parser[T] {
+	return func(in string, pos int) (T, int, error) {
+		var zero T
+		var best error
+		for _, p := range ps {
+			v, next, err := p(in, pos)
+			if err == nil {
+				return v, next, nil
+			}
+			if best == nil || column(err) > column(best) {
+				best = err
+			}
+		}
+		if best == nil {
+			best = fail(in, pos, "no alternatives")
+		}
+		return zero, pos, best
+	}
+}
+
+// Map parses p and converts its result using f.
+func Map[T, R any](p Parser[T], f func(T) R) Parser[R] {
+	return func(in string, pos int) (R, int, error) {
+		var zero R
+		v, next, err := p(in, pos)
+		if err != nil {
+			return zero, pos, err
+		}
+		return f(v), next, nil
+	}
+}
+
+// Seq2 parses a, then b, and combines their results using f.
+func Seq2[A, B, R any](a Parser[A], b Parser[B], f func(A, B) R) Parser[R] {
+	return func(in string, pos int) (R, int, error) {
+		var zero R
+		va, next, err := a(in, pos)
+		if err != nil {
+			return zero, pos, err
+		}
+		vb, next, err := b(in, next)
+		if err != nil {
+			return zero, pos, err
+		}
+		return f(va, vb), next, nil
+	}
+}
+
+// Seq3 parses a, b and c in turn and combines their results using f.
+func Seq3[A, B, C, R any](a Parser[A], b Parser[B], c Parser[C], f func(A, B, C) R) Parser[R] {
+	ab := Seq2(a, b, func(va A, vb B) func(C) R {
+		return func(vc C) R { return f(va, vb, vc) }
+	})
+	return Seq2(ab, c, func(g func(C) R, vc C) R { return g(vc) })
+}
+
+// Between parses open, p and close in turn and returns the result of p.
+func Between[T any](open string, p Parser[T], close string) Parser[T] {
+	return Seq3(Literal(open), p, Literal(close), func(_ string, v T, _ string) T { return v })
+}
+
+// Prefixed parses the literal prefix followed by p and returns the result of p.
+func Prefixed[T any](prefix string, p Parser[T]) Parser[T] {
+	return Seq2(Literal(prefix), p, func(_ string, v T) T { return v })
+}
+
+// Lazy defers the construction of a parser until it is used, which allows
+// for recursive grammars.
+func Lazy[T any](f func() Parser[T]) Parser[T] {
+	return func(in string, pos int) (T, int, error) {
+		return f()(in, pos)
+	}
+}
+
+// Parse parses all of s using p. It is an error if input is left over.
+func Parse[T any](p Parser[T], s string) (T, error) {
+	var zero T
+	v, pos, err := p(s, 0)
+	if err != nil {
+		return zero, err
+	}
+	if pos != len(s) {
+		return zero, fail(s, pos, "unexpected trailing text")
+	}
+	return v, nil
+}
+
+// ParseLines parses each line read from r until EOF using p. Errors carry
+// the line number.
+func ParseLines[T any](p Parser[T], r io.Reader) ([]T, error) {
+	var result []T
+	s := bufio.NewScanner(r)
+	for line := 1; s.Scan(); line++ {
+		v, err := Parse(p, s.Text())
+		if err != nil {
+			return nil, parse.AtLine(err, line)
+		}
+		result = append(result, v)
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
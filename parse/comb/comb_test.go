@@ -0,0 +1,149 @@
+package comb
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/Xjs/aoc2023/parse"
+)
+
+type rule struct {
+	Category string
+	Op       string
+	Value    int
+	Target   string
+}
+
+type workflow struct {
+	Name     string
+	Rules    []rule
+	Fallback string
+}
+
+var workflowParser = Seq2(Word(), Between("{", Seq2(
+	Many(Seq3(
+		Seq3(Word(), Choice(Literal("<"), Literal(">")), Int(), func(c, op string, v int) rule {
+			return rule{Category: c, Op: op, Value: v}
+		}),
+		Prefixed(":", Word()),
+		Literal(","),
+		func(r rule, target, _ string) rule {
+			r.Target = target
+			return r
+		},
+	)),
+	Word(),
+	func(rules []rule, fallback string) workflow { return workflow{Rules: rules, Fallback: fallback} },
+), "}"), func(name string, w workflow) workflow {
+	w.Name = name
+	return w
+})
+
+func TestWorkflow(t *testing.T) {
+	got, err := Parse(workflowParser, "px{a<2006:qkq,m>2090:A,rfg}")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := workflow{
+		Name: "px",
+		Rules: []rule{
+			{"a", "<", 2006, "qkq"},
+			{"m", ">", 2090, "A"},
+		},
+		Fallback: "rfg",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Parse() = %+v, want %+v", got, want)
+	}
+
+	_, err = Parse(workflowParser, "px{a=2006:qkq,rfg}")
+	var pe *parse.Error
+	if !errors.As(err, &pe) || pe.Column != 5 {
+		t.Errorf("Parse() error = %v, want error at column 5", err)
+	}
+}
+
+type module struct {
+	Kind    *string
+	Name    string
+	Targets []string
+}
+
+func TestParseLines(t *testing.T) {
+	p := Seq3(
+		Optional(Choice(Literal("%"), Literal("&"))),
+		Word(),
+		Prefixed(" -> ", Sep(Word(), ", ")),
+		func(kind *string, name string, targets []string) module {
+			return module{Kind: kind, Name: name, Targets: targets}
+		},
+	)
+
+	got, err := ParseLines(p, strings.NewReader("broadcaster -> a, b\n%a -> b\n&inv -> a"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 3 {
+		t.Fatalf("got %d modules, want 3", len(got))
+	}
+	if got[0].Kind != nil || got[0].Name != "broadcaster" || !reflect.DeepEqual(got[0].Targets, []string{"a", "b"}) {
+		t.Errorf("module 0 = %+v", got[0])
+	}
+	if got[1].Kind == nil || *got[1].Kind != "%" || got[2].Kind == nil || *got[2].Kind != "&" {
+		t.Errorf("modules 1 and 2 have wrong kinds")
+	}
+
+	_, err = ParseLines(p, strings.NewReader("%a -> b\n%a -> b,"))
+	var pe *parse.Error
+	if !errors.As(err, &pe) || pe.Line != 2 {
+		t.Errorf("ParseLines() error = %v, want error in line 2", err)
+	}
+}
+
+// A value is either an int or a list of values.
+type value struct {
+	n    int
+	list []value
+}
+
+func (v value) String() string {
+	if v.list == nil {
+		return strconv.Itoa(v.n)
+	}
+	parts := make([]string, len(v.list))
+	for i, e := range v.list {
+		parts[i] = e.String()
+	}
+	return "[" + strings.Join(parts, ",") + "]"
+}
+
+func TestNestedLists(t *testing.T) {
+	var list Parser[value]
+	list = Choice(
+		Map(Int(), func(n int) value { return value{n: n} }),
+		Map(Between("[", Optional(Sep(Lazy(func() Parser[value] { return list }), ",")), "]"), func(vs *[]value) value {
+			if vs == nil {
+				return value{list: []value{}}
+			}
+			return value{list: *vs}
+		}),
+	)
+
+	for _, s := range []string{"[1,[2,[3,[4,[5,6,7]]]],8,9]", "[[]]", "[]", "7"} {
+		got, err := Parse(list, s)
+		if err != nil {
+			t.Errorf("Parse(%q) error = %v", s, err)
+			continue
+		}
+		if got.String() != s {
+			t.Errorf("Parse(%q) = %v", s, got)
+		}
+	}
+
+	if _, err := Parse(list, "[1,[2]"); err == nil {
+		t.Error("expected error for unbalanced brackets")
+	}
+}
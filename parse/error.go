@@ -0,0 +1,100 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// An Error is a parse error at a specific position of the input.
+type Error struct {
+	// Line is the 1-based line number, or 0 if unknown.
+	Line int
+	// Column is the 1-based byte offset into Input, or 0 if unknown.
+	Column int
+	// Input is the line that failed to parse.
+	Input string
+	// Err is the underlying error.
+	Err error
+}
+
+func (e *Error) Error() string {
+	var pos []string
+	if e.Line > 0 {
+		pos = append(pos, fmt.Sprintf("line %d", e.Line))
+	}
+	if e.Column > 0 {
+		pos = append(pos, fmt.Sprintf("column %d", e.Column))
+	}
+	if len(pos) == 0 {
+		return e.Err.Error()
+	}
+	return strings.Join(pos, ", ") + ": " + e.Err.Error()
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// caretContext is the number of bytes of input shown on either side of the
+// column by Caret.
+const caretContext = 30
+
+// Caret returns the error message, followed by the relevant part of the input
+// and a caret pointing at the failing column:
+//
+//	line 2, column 5: strconv.Atoi: parsing "x": invalid syntax
+//	    1,2,x,4
+//	        ^
+func (e *Error) Caret() string {
+	var b strings.Builder
+	b.WriteString(e.Error())
+	if e.Input == "" {
+		return b.String()
+	}
+
+	// Column may point just past the end of the input, e. g. for an
+	// unexpected end of line, but not further.
+	col := min(e.Column, len(e.Input)+1)
+	start, end := 0, len(e.Input)
+	if col > caretContext+1 {
+		start = runeStart(e.Input, col-1-caretContext)
+	}
+	end = runeStart(e.Input, min(end, max(col-1, 0)+caretContext))
+	caret := runeStart(e.Input, max(col-1, 0))
+
+	prefix, suffix := "    ", ""
+	if start > 0 {
+		prefix += "…"
+	}
+	if end < len(e.Input) {
+		suffix = "…"
+	}
+	b.WriteString("\n" + prefix + e.Input[start:end] + suffix)
+	if col > 0 {
+		b.WriteString("\n" + strings.Repeat(" ", utf8.RuneCountInString(prefix)+utf8.RuneCountInString(e.Input[start:caret])) + "^")
+	}
+	return b.String()
+}
+
+// runeStart backs i up to the start of the rune it points into.
+func runeStart(s string, i int) int {
+	for i > 0 && i < len(s) && !utf8.RuneStart(s[i]) {
+		i--
+	}
+	return i
+}
+
+// AtLine sets the line number of err if it is an *Error itself, or wraps err
+// in an *Error with the given line number otherwise. It returns nil if err is nil.
+func AtLine(err error, line int) error {
+	if err == nil {
+		return nil
+	}
+	if pe, ok := err.(*Error); ok {
+		withLine := *pe
+		withLine.Line = line
+		return &withLine
+	}
+	return &Error{Line: line, Err: err}
+}
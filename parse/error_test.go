@@ -0,0 +1,86 @@
+package parse
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestIntListSep_error(t *testing.T) {
+	tests := []struct {
+		name    string
+		parse   func() ([]int, error)
+		wantCol int
+	}{
+		{"comma", func() ([]int, error) { return IntList("1, 2,  x,4") }, 8},
+		{"sep", func() ([]int, error) { return IntListSep("1 -> 2 -> y", "->") }, 11},
+		{"whitespace", func() ([]int, error) { return IntListWhitespace("  1  2 z3") }, 8},
+		{"digits", func() ([]int, error) { return DigitList("12a4") }, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ns, err := tt.parse()
+			if ns != nil {
+				t.Errorf("got partial result %v", ns)
+			}
+			var pe *Error
+			if !errors.As(err, &pe) {
+				t.Fatalf("error %v is not an *Error", err)
+			}
+			if pe.Column != tt.wantCol {
+				t.Errorf("Column = %d, want %d", pe.Column, tt.wantCol)
+			}
+			if !errors.Is(err, strconv.ErrSyntax) {
+				t.Errorf("error %v does not wrap strconv.ErrSyntax", err)
+			}
+		})
+	}
+}
+
+func TestError_Caret(t *testing.T) {
+	_, err := IntList("1,2,x,4")
+	err = AtLine(err, 2)
+	var pe *Error
+	if !errors.As(err, &pe) {
+		t.Fatalf("error %v is not an *Error", err)
+	}
+	want := `line 2, column 5: strconv.Atoi: parsing "x": invalid syntax
+    1,2,x,4
+        ^`
+	if got := pe.Caret(); got != want {
+		t.Errorf("Error.Caret() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestError_Caret_bounds(t *testing.T) {
+	errX := errors.New("x")
+	tests := []struct {
+		name string
+		e    *Error
+		want string
+	}{
+		{
+			name: "past end",
+			e:    &Error{Column: 10, Input: "abc", Err: errX},
+			want: "column 10: x\n    abc\n       ^",
+		},
+		{
+			name: "end of line",
+			e:    &Error{Column: 4, Input: "abc", Err: errX},
+			want: "column 4: x\n    abc\n       ^",
+		},
+		{
+			name: "multi-byte",
+			e:    &Error{Column: 42, Input: strings.Repeat("ä", 40), Err: errX},
+			want: "column 42: x\n    …" + strings.Repeat("ä", 30) + "…\n" + strings.Repeat(" ", 20) + "^",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.e.Caret(); got != tt.want {
+				t.Errorf("Error.Caret() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
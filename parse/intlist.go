@@ -1,8 +1,12 @@
 package parse
 
 import (
+	"math/big"
 	"strconv"
 	"strings"
+	"unicode"
+
+	"github.com/Xjs/aoc2023/integer"
 )
 
 // IntList takes a string with comma-separated integers (may be surrounded by whitespace) and returns the integers as a slice.
@@ -12,30 +16,116 @@ func IntList(s string) ([]int, error) {
 
 // IntListWhitespace takes a string with whitespace-separated integers (may be surrounded by whitespace) and returns the integers as a slice.
 func IntListWhitespace(s string) ([]int, error) {
-	fields := strings.Fields(s)
-	ns := make([]int, 0, len(fields))
+	return listWhitespace(s, strconv.Atoi)
+}
 
-	for _, field := range fields {
-		n, err := strconv.Atoi(field)
+// IntListSep takes a string with sep-separated integers (may be surrounded by whitespace) and returns the integers as a slice.
+func IntListSep(s string, sep string) ([]int, error) {
+	return listSep(s, sep, strconv.Atoi)
+}
+
+// ParseInteger parses a decimal integer of type T with an optional leading
+// '+' or '-' sign. Values that don't fit into T are reported as an error
+// wrapping strconv.ErrRange.
+func ParseInteger[T integer.Integer](s string) (T, error) {
+	var zero T
+	if zero-1 < zero {
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err == nil && int64(T(n)) != n {
+			err = &strconv.NumError{Func: "ParseInteger", Num: s, Err: strconv.ErrRange}
+		}
+		return T(n), err
+	}
+	n, err := strconv.ParseUint(strings.TrimPrefix(s, "+"), 10, 64)
+	if err != nil {
+		err.(*strconv.NumError).Num = s
+	} else if uint64(T(n)) != n {
+		err = &strconv.NumError{Func: "ParseInteger", Num: s, Err: strconv.ErrRange}
+	}
+	return T(n), err
+}
+
+// IntegerList is IntList for integers of type T, see ParseInteger.
+func IntegerList[T integer.Integer](s string) ([]T, error) {
+	return IntegerListSep[T](s, ",")
+}
+
+// IntegerListWhitespace is IntListWhitespace for integers of type T, see ParseInteger.
+func IntegerListWhitespace[T integer.Integer](s string) ([]T, error) {
+	return listWhitespace(s, ParseInteger[T])
+}
+
+// IntegerListSep is IntListSep for integers of type T, see ParseInteger.
+func IntegerListSep[T integer.Integer](s string, sep string) ([]T, error) {
+	return listSep(s, sep, ParseInteger[T])
+}
+
+// ParseBigInt parses a decimal integer of arbitrary size with an optional
+// leading '+' or '-' sign.
+func ParseBigInt(s string) (*big.Int, error) {
+	n, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return nil, &strconv.NumError{Func: "ParseBigInt", Num: s, Err: strconv.ErrSyntax}
+	}
+	return n, nil
+}
+
+// BigIntList is IntList for integers of arbitrary size.
+func BigIntList(s string) ([]*big.Int, error) {
+	return BigIntListSep(s, ",")
+}
+
+// BigIntListWhitespace is IntListWhitespace for integers of arbitrary size.
+func BigIntListWhitespace(s string) ([]*big.Int, error) {
+	return listWhitespace(s, ParseBigInt)
+}
+
+// BigIntListSep is IntListSep for integers of arbitrary size.
+func BigIntListSep(s string, sep string) ([]*big.Int, error) {
+	return listSep(s, sep, ParseBigInt)
+}
+
+// listWhitespace splits s at whitespace and converts each field using conv.
+func listWhitespace[T any](s string, conv func(string) (T, error)) ([]T, error) {
+	var ns []T
+	for i := 0; i < len(s); {
+		start := strings.IndexFunc(s[i:], func(r rune) bool { return !unicode.IsSpace(r) })
+		if start < 0 {
+			break
+		}
+		start += i
+		end := strings.IndexFunc(s[start:], unicode.IsSpace)
+		if end < 0 {
+			end = len(s)
+		} else {
+			end += start
+		}
+
+		n, err := conv(s[start:end])
 		if err != nil {
-			return ns, err
+			return nil, &Error{Column: start + 1, Input: s, Err: err}
 		}
 		ns = append(ns, n)
+		i = end
 	}
 	return ns, nil
 }
 
-// IntListSep takes a string with sep-separated integers (may be surrounded by whitespace) and returns the integers as a slice.
-func IntListSep(s string, sep string) ([]int, error) {
+// listSep splits s at sep and converts each field, trimmed of surrounding
+// whitespace, using conv.
+func listSep[T any](s string, sep string, conv func(string) (T, error)) ([]T, error) {
 	fields := strings.Split(s, sep)
-	ns := make([]int, 0, len(fields))
+	ns := make([]T, 0, len(fields))
 
+	offset := 0
 	for _, field := range fields {
-		n, err := strconv.Atoi(strings.TrimSpace(field))
+		trimmed := strings.TrimLeftFunc(field, unicode.IsSpace)
+		n, err := conv(strings.TrimSpace(trimmed))
 		if err != nil {
-			return ns, err
+			return nil, &Error{Column: offset + len(field) - len(trimmed) + 1, Input: s, Err: err}
 		}
 		ns = append(ns, n)
+		offset += len(field) + len(sep)
 	}
 	return ns, nil
 }
@@ -44,10 +134,10 @@ func IntListSep(s string, sep string) ([]int, error) {
 func DigitList(s string) ([]int, error) {
 	ns := make([]int, 0, len(s))
 
-	for _, r := range s {
+	for i, r := range s {
 		n, err := strconv.Atoi(string(r))
 		if err != nil {
-			return ns, err
+			return nil, &Error{Column: i + 1, Input: s, Err: err}
 		}
 		ns = append(ns, n)
 	}
@@ -0,0 +1,67 @@
+package parse
+
+import (
+	"errors"
+	"math/big"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestIntegerList(t *testing.T) {
+	got64, err := IntegerListWhitespace[int64]("  4294967296 -9223372036854775808 +7 ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []int64{1 << 32, -1 << 63, 7}; !reflect.DeepEqual(got64, want) {
+		t.Errorf("IntegerListWhitespace[int64]() = %v, want %v", got64, want)
+	}
+
+	gotU, err := IntegerListSep[uint64]("18446744073709551615 -> +1", "->")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []uint64{1<<64 - 1, 1}; !reflect.DeepEqual(gotU, want) {
+		t.Errorf("IntegerListSep[uint64]() = %v, want %v", gotU, want)
+	}
+
+	overflows := []func() error{
+		func() error { _, err := IntegerList[int8]("1,128"); return err },
+		func() error { _, err := IntegerList[int32]("-2147483649"); return err },
+		func() error { _, err := IntegerList[uint16]("65536"); return err },
+		func() error { _, err := IntegerList[int64]("9223372036854775808"); return err },
+		func() error { _, err := IntegerList[uint64]("18446744073709551616"); return err },
+	}
+	for i, f := range overflows {
+		if err := f(); !errors.Is(err, strconv.ErrRange) {
+			t.Errorf("overflow %d: error = %v, want strconv.ErrRange", i, err)
+		}
+	}
+
+	if _, err := IntegerList[uint]("-1"); err == nil {
+		t.Error("IntegerList[uint](\"-1\") succeeded, want error")
+	}
+}
+
+func TestBigIntList(t *testing.T) {
+	got, err := BigIntList("123456789012345678901234567890, -5,+6")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"123456789012345678901234567890", "-5", "6"}
+	for i, n := range got {
+		if n.String() != want[i] {
+			t.Errorf("BigIntList()[%d] = %v, want %v", i, n, want[i])
+		}
+	}
+
+	_, err = BigIntListWhitespace("1 2x")
+	var pe *Error
+	if !errors.As(err, &pe) || pe.Column != 3 || !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("BigIntListWhitespace() error = %v, want syntax error at column 3", err)
+	}
+
+	if n, _ := ParseBigInt("-0"); n.Cmp(new(big.Int)) != 0 {
+		t.Errorf("ParseBigInt(\"-0\") = %v, want 0", n)
+	}
+}
@@ -0,0 +1,102 @@
+package parse
+
+import (
+	"math"
+	"strconv"
+)
+
+// IntsOptions control how Ints extracts integers from text.
+type IntsOptions struct {
+	// WordHyphens treats a '-' directly following a letter or digit as a
+	// hyphen rather than a minus sign, so that "x-5" yields 5 and
+	// "2023-12-05" yields 2023, 12, 5.
+	WordHyphens bool
+}
+
+// Ints returns all integers in s, ignoring any other text. A '-' directly
+// preceding a digit is a minus sign. Numbers that don't fit into an int are
+// skipped; use CheckedInts to detect them.
+func Ints(s string) []int {
+	return IntsOptions{}.Ints(s)
+}
+
+// CheckedInts is Ints, but returns an *Error wrapping strconv.ErrRange
+// instead of skipping numbers that don't fit into an int.
+func CheckedInts(s string) ([]int, error) {
+	return IntsOptions{}.CheckedInts(s)
+}
+
+// AppendInts is Ints, but appends the integers to dst and works on a byte
+// slice, so that no allocations are needed if dst has sufficient capacity.
+func AppendInts(dst []int, b []byte) []int {
+	return IntsOptions{}.AppendInts(dst, b)
+}
+
+// Ints is Ints with options o.
+func (o IntsOptions) Ints(s string) []int {
+	ns, _ := appendInts(nil, s, o, false)
+	return ns
+}
+
+// CheckedInts is CheckedInts with options o.
+func (o IntsOptions) CheckedInts(s string) ([]int, error) {
+	return appendInts(nil, s, o, true)
+}
+
+// AppendInts is AppendInts with options o.
+func (o IntsOptions) AppendInts(dst []int, b []byte) []int {
+	ns, _ := appendInts(dst, b, o, false)
+	return ns
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+func isWordByte(c byte) bool {
+	return isDigit(c) || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || c >= 0x80
+}
+
+// appendInts appends the integers in s to dst. Numbers that don't fit into
+// an int are skipped, or reported as an error if checked is set.
+func appendInts[S string | []byte](dst []int, s S, o IntsOptions, checked bool) ([]int, error) {
+	for i := 0; i < len(s); i++ {
+		if !isDigit(s[i]) {
+			continue
+		}
+
+		start := i
+		negative := i > 0 && s[i-1] == '-'
+		if negative && o.WordHyphens && i > 1 && isWordByte(s[i-2]) {
+			negative = false
+		}
+		limit := uint64(math.MaxInt)
+		if negative {
+			start--
+			limit++
+		}
+
+		var n uint64
+		overflow := false
+		for ; i < len(s) && isDigit(s[i]); i++ {
+			d := uint64(s[i] - '0')
+			if n > (limit-d)/10 {
+				overflow = true
+			}
+			n = 10*n + d
+		}
+		if overflow {
+			if checked {
+				numErr := &strconv.NumError{Func: "Ints", Num: string(s[start:i]), Err: strconv.ErrRange}
+				return nil, &Error{Column: start + 1, Input: string(s), Err: numErr}
+			}
+			continue
+		}
+		if negative {
+			dst = append(dst, int(-n))
+		} else {
+			dst = append(dst, int(n))
+		}
+	}
+	return dst, nil
+}
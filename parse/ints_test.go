@@ -0,0 +1,85 @@
+package parse
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestInts(t *testing.T) {
+	tests := []struct {
+		name string
+		opts IntsOptions
+		s    string
+		want []int
+	}{
+		{"card", IntsOptions{}, "Card   1: 41 48 | 83 86", []int{1, 41, 48, 83, 86}},
+		{"negative", IntsOptions{}, "p=-3,4 v=5,-12", []int{-3, 4, 5, -12}},
+		{"double minus", IntsOptions{}, "--7 - 8", []int{-7, 8}},
+		{"date", IntsOptions{}, "2023-12-05", []int{2023, -12, -5}},
+		{"date word hyphens", IntsOptions{WordHyphens: true}, "2023-12-05", []int{2023, 12, 5}},
+		{"word hyphens", IntsOptions{WordHyphens: true}, "x-5 = -3, -4", []int{5, -3, -4}},
+		{"none", IntsOptions{}, "seed-to-soil map:", nil},
+		{"extremes", IntsOptions{}, "9223372036854775807 -9223372036854775808", []int{math.MaxInt, math.MinInt}},
+		{"out of range", IntsOptions{}, "1 9223372036854775808 -9223372036854775809 2", []int{1, 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.opts.Ints(tt.s); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("IntsOptions.Ints() = %v, want %v", got, tt.want)
+			}
+			if got, err := tt.opts.CheckedInts(tt.s); err == nil && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("IntsOptions.CheckedInts() = %v, want %v", got, tt.want)
+			}
+			if got := tt.opts.AppendInts(nil, []byte(tt.s)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("IntsOptions.AppendInts() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckedInts(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		wantCol int
+	}{
+		{"positive", "a 99999999999999999999 b", 3},
+		{"negative", "x=-9223372036854775809", 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ns, err := CheckedInts(tt.s)
+			if ns != nil {
+				t.Errorf("got partial result %v", ns)
+			}
+			var pe *Error
+			if !errors.As(err, &pe) {
+				t.Fatalf("error %v is not an *Error", err)
+			}
+			if pe.Column != tt.wantCol {
+				t.Errorf("Column = %d, want %d", pe.Column, tt.wantCol)
+			}
+			if !errors.Is(err, strconv.ErrRange) {
+				t.Errorf("error %v does not wrap strconv.ErrRange", err)
+			}
+		})
+	}
+
+	if ns, err := CheckedInts("1 -2 3"); err != nil || !reflect.DeepEqual(ns, []int{1, -2, 3}) {
+		t.Errorf("CheckedInts() = %v, %v, want [1 -2 3]", ns, err)
+	}
+}
+
+func TestAppendInts_allocs(t *testing.T) {
+	b := []byte("Game 1: 3 blue, 4 red; 1 red, 2 green, 6 blue")
+	dst := make([]int, 0, 16)
+	allocs := testing.AllocsPerRun(100, func() {
+		dst = AppendInts(dst[:0], b)
+	})
+	if allocs != 0 {
+		t.Errorf("AppendInts allocated %v times, want 0", allocs)
+	}
+}
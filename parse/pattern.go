@@ -0,0 +1,211 @@
+package parse
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// A Pattern matches lines against a template such as
+//
+//	Card {id:int}: {win:ints} | {have:ints}
+//
+// Text outside of braces must match literally ("{{" and "}}" stand for
+// literal braces). A placeholder {name:type} captures everything up to the
+// next literal text, or up to the end of the line if it is the last part
+// of the template. The captured text is converted according to type:
+//
+//	int   an integer, surrounding whitespace is ignored
+//	ints  whitespace-separated integers (see IntListWhitespace)
+//	word  a non-empty string without whitespace, surrounding whitespace is ignored
+//	rest  the captured text as is (the default if the type is omitted)
+type Pattern struct {
+	parts []patternPart
+}
+
+type patternPart struct {
+	literal string
+	// name and kind are only set for placeholders.
+	name, kind string
+}
+
+func (p patternPart) placeholder() bool {
+	return p.name != ""
+}
+
+// CompilePattern parses a template into a Pattern. It returns an error if the
+// template is malformed, e. g. if two placeholders are adjacent.
+func CompilePattern(template string) (*Pattern, error) {
+	var p Pattern
+	var lit strings.Builder
+	flush := func() {
+		if lit.Len() > 0 {
+			p.parts = append(p.parts, patternPart{literal: lit.String()})
+			lit.Reset()
+		}
+	}
+
+	for i := 0; i < len(template); i++ {
+		c := template[i]
+		switch {
+		case c == '{' && strings.HasPrefix(template[i:], "{{"),
+			c == '}' && strings.HasPrefix(template[i:], "}}"):
+			lit.WriteByte(c)
+			i++
+		case c == '{':
+			end := strings.IndexByte(template[i:], '}')
+			if end < 0 {
+				return nil, fmt.Errorf("template %q: unclosed brace at column %d", template, i+1)
+			}
+			name, kind, _ := strings.Cut(template[i+1:i+end], ":")
+			if kind == "" {
+				kind = "rest"
+			}
+			if name == "" {
+				return nil, fmt.Errorf("template %q: empty placeholder name at column %d", template, i+1)
+			}
+			switch kind {
+			case "int", "ints", "word", "rest":
+			default:
+				return nil, fmt.Errorf("template %q: unknown type %q of placeholder %q", template, kind, name)
+			}
+			flush()
+			if n := len(p.parts); n > 0 && p.parts[n-1].placeholder() {
+				return nil, fmt.Errorf("template %q: placeholders %q and %q are adjacent", template, p.parts[n-1].name, name)
+			}
+			p.parts = append(p.parts, patternPart{name: name, kind: kind})
+			i += end
+		case c == '}':
+			return nil, fmt.Errorf("template %q: unmatched closing brace at column %d", template, i+1)
+		default:
+			lit.WriteByte(c)
+		}
+	}
+	flush()
+
+	return &p, nil
+}
+
+// MustCompilePattern is CompilePattern, but panics instead of returning an error.
+func MustCompilePattern(template string) *Pattern {
+	p, err := CompilePattern(template)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// Match matches line against p and returns the converted captures by name.
+// Values are of type int, []int or string, depending on the placeholder type.
+// If line doesn't match, the error is an *Error.
+func (p *Pattern) Match(line string) (map[string]any, error) {
+	result := make(map[string]any)
+	pos := 0
+	for i, part := range p.parts {
+		if !part.placeholder() {
+			if !strings.HasPrefix(line[pos:], part.literal) {
+				return nil, &Error{Column: pos + 1, Input: line, Err: fmt.Errorf("expected %q", part.literal)}
+			}
+			pos += len(part.literal)
+			continue
+		}
+
+		end := len(line)
+		if i+1 < len(p.parts) {
+			next := p.parts[i+1].literal
+			idx := strings.Index(line[pos:], next)
+			if idx < 0 {
+				return nil, &Error{Column: pos + 1, Input: line, Err: fmt.Errorf("field %q: expected %q after it", part.name, next)}
+			}
+			end = pos + idx
+		}
+
+		v, err := convert(line[pos:end], part.kind)
+		if err != nil {
+			col := pos + 1
+			if pe, ok := err.(*Error); ok {
+				col, err = pos+pe.Column, pe.Err
+			}
+			return nil, &Error{Column: col, Input: line, Err: fmt.Errorf("field %q: %w", part.name, err)}
+		}
+		result[part.name] = v
+		pos = end
+	}
+
+	if pos != len(line) {
+		return nil, &Error{Column: pos + 1, Input: line, Err: errors.New("unexpected trailing text")}
+	}
+
+	return result, nil
+}
+
+func convert(s, kind string) (any, error) {
+	switch kind {
+	case "int":
+		return strconv.Atoi(strings.TrimSpace(s))
+	case "ints":
+		return IntListWhitespace(s)
+	case "word":
+		w := strings.TrimSpace(s)
+		if w == "" || strings.ContainsAny(w, " \t") {
+			return nil, fmt.Errorf("%q is not a single word", s)
+		}
+		return w, nil
+	}
+	return s, nil
+}
+
+// Fill matches line against p and stores the captures in the struct dst
+// points to. A capture is stored in the field tagged with `parse:"name"`,
+// or else in the exported field whose name equals the capture name ignoring
+// case. Captures are assigned in template order; it is an error if a tagged
+// field is unexported.
+func (p *Pattern) Fill(line string, dst any) error {
+	rv := reflect.ValueOf(dst)
+	if rv.Kind() != reflect.Pointer || rv.Elem().Kind() != reflect.Struct {
+		return errors.New("destination must be a pointer to a struct")
+	}
+	rv = rv.Elem()
+
+	values, err := p.Match(line)
+	if err != nil {
+		return err
+	}
+
+	for _, part := range p.parts {
+		if !part.placeholder() {
+			continue
+		}
+		name := part.name
+		field, ok := fieldByCapture(rv, name)
+		if !ok {
+			return fmt.Errorf("no field for capture %q in %s", name, rv.Type())
+		}
+		if !field.CanSet() {
+			return fmt.Errorf("field for capture %q is unexported", name)
+		}
+		val := reflect.ValueOf(values[name])
+		if !val.Type().AssignableTo(field.Type()) {
+			return fmt.Errorf("capture %q: cannot assign %s to field of type %s", name, val.Type(), field.Type())
+		}
+		field.Set(val)
+	}
+	return nil
+}
+
+func fieldByCapture(rv reflect.Value, name string) (reflect.Value, bool) {
+	t := rv.Type()
+	for i := 0; i < t.NumField(); i++ {
+		if t.Field(i).Tag.Get("parse") == name {
+			return rv.Field(i), true
+		}
+	}
+	for i := 0; i < t.NumField(); i++ {
+		if t.Field(i).IsExported() && strings.EqualFold(t.Field(i).Name, name) {
+			return rv.Field(i), true
+		}
+	}
+	return reflect.Value{}, false
+}
@@ -0,0 +1,89 @@
+package parse
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestPattern_Match(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		line     string
+		want     map[string]any
+		wantErr  string
+	}{
+		{"card", "Card {id:int}: {win:ints} | {have:ints}", "Card   3:  1 21 | 69 82 1",
+			map[string]any{"id": 3, "win": []int{1, 21}, "have": []int{69, 82, 1}}, ""},
+		{"game", "Game {id:int}: {rest}", "Game 12: 3 blue; 4 red",
+			map[string]any{"id": 12, "rest": "3 blue; 4 red"}, ""},
+		{"word", "{from:word} -> {to}", "broadcaster -> a, b",
+			map[string]any{"from": "broadcaster", "to": "a, b"}, ""},
+		{"braces", "{{{name:word}}}", "{px}", map[string]any{"name": "px"}, ""},
+		{"bad int", "Game {id:int}: {rest}", "Game x1: 3 blue", nil, `column 6: field "id"`},
+		{"bad prefix", "Game {id:int}: {rest}", "Gaem 1: 3 blue", nil, `column 1: expected "Game "`},
+		{"missing literal", "Card {id:int}: {win:ints} | {have:ints}", "Card 1: 1 2 3", nil, `field "win": expected " | "`},
+		{"trailing", "{a:int}!", "1!x", nil, "column 3: unexpected trailing text"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MustCompilePattern(tt.template).Match(tt.line)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("Pattern.Match() error = %v, want error containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Pattern.Match() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Pattern.Match() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompilePattern(t *testing.T) {
+	for _, template := range []string{"{a}{b}", "{a", "a}", "{:int}", "{a:float}"} {
+		if _, err := CompilePattern(template); err == nil {
+			t.Errorf("CompilePattern(%q) succeeded, want error", template)
+		}
+	}
+}
+
+func TestPattern_Fill(t *testing.T) {
+	var card struct {
+		ID      int
+		Winning []int `parse:"win"`
+		Have    []int
+	}
+	p := MustCompilePattern("Card {id:int}: {win:ints} | {have:ints}")
+	if err := p.Fill("Card 1: 41 48 | 83 86", &card); err != nil {
+		t.Fatal(err)
+	}
+	if card.ID != 1 || !reflect.DeepEqual(card.Winning, []int{41, 48}) || !reflect.DeepEqual(card.Have, []int{83, 86}) {
+		t.Errorf("Pattern.Fill() = %+v", card)
+	}
+
+	var wrong struct{ ID string }
+	if err := MustCompilePattern("{id:int}").Fill("1", &wrong); err == nil {
+		t.Error("expected error for mismatched field type")
+	}
+
+	var unexported struct {
+		id int `parse:"id"`
+	}
+	if err := MustCompilePattern("{id:int}").Fill("1", &unexported); err == nil {
+		t.Error("expected error for unexported field")
+	}
+
+	var twoWrong struct{ A, B string }
+	for i := 0; i < 10; i++ {
+		err := MustCompilePattern("{a:int},{b:int}").Fill("1,2", &twoWrong)
+		if err == nil || !strings.Contains(err.Error(), `capture "a"`) {
+			t.Fatalf("Pattern.Fill() error = %v, want error for capture \"a\"", err)
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package parse
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"strings"
+)
+
+// A Section is a block of lines delimited by blank lines.
+type Section struct {
+	// Title and Values are only set if SectionOptions.Titled is set:
+	// A first line "seeds: 79 14 55 13" has Title "seeds" and
+	// Values "79 14 55 13".
+	Title, Values string
+	// Lines are the lines of the section, excluding the title line if
+	// SectionOptions.Titled is set.
+	Lines []string
+}
+
+// SectionOptions control how a SectionScanner splits its input.
+type SectionOptions struct {
+	// KeepEmpty reports empty sections caused by consecutive blank lines or
+	// by blank lines at the beginning of the input. By default, these are
+	// skipped. Blank lines at the end of the input never start a section.
+	KeepEmpty bool
+	// Blank lines are lines that are empty or, if TrimSpace is set, only
+	// consist of whitespace. With TrimSpace, all lines are trimmed as well.
+	TrimSpace bool
+	// Titled splits the first line of each section at the first colon into
+	// Title and Values, both trimmed of surrounding whitespace. It is an error
+	// if the first line has no colon.
+	Titled bool
+}
+
+// A SectionScanner reads blank-line-separated sections one at a time, in the
+// manner of bufio.Scanner.
+type SectionScanner struct {
+	s       *bufio.Scanner
+	opts    SectionOptions
+	section Section
+	line    int
+	err     error
+	done    bool
+	// pending is the number of empty sections to report before next.
+	// Empty sections are only reported once a non-empty one follows them.
+	pending int
+	next    *Section
+}
+
+// NewSectionScanner returns a SectionScanner reading from r.
+func NewSectionScanner(r io.Reader, opts SectionOptions) *SectionScanner {
+	return &SectionScanner{s: bufio.NewScanner(r), opts: opts}
+}
+
+// Scan advances to the next section, which is then available through
+// Section. It returns false when there are no more sections or an error
+// occurred.
+func (s *SectionScanner) Scan() bool {
+	switch {
+	case s.pending > 0:
+		s.pending--
+		s.section = Section{}
+		return true
+	case s.next != nil:
+		s.section, s.next = *s.next, nil
+		return true
+	case s.done:
+		return false
+	}
+
+	var lines []string
+	empty := 0
+	start := s.line + 1
+	for {
+		if !s.s.Scan() {
+			s.done = true
+			if err := s.s.Err(); err != nil {
+				s.err = err
+				return false
+			}
+			break
+		}
+		s.line++
+
+		line := s.s.Text()
+		if s.opts.TrimSpace {
+			line = strings.TrimSpace(line)
+		}
+		if line != "" {
+			lines = append(lines, line)
+			continue
+		}
+		if len(lines) > 0 {
+			break
+		}
+		if s.opts.KeepEmpty {
+			empty++
+		}
+		start = s.line + 1
+	}
+	if len(lines) == 0 {
+		// Blank lines at the end of the input never start a section.
+		return false
+	}
+
+	section := Section{Lines: lines}
+	if s.opts.Titled {
+		title, values, ok := strings.Cut(lines[0], ":")
+		if !ok {
+			s.err = &Error{Line: start, Input: lines[0], Err: errors.New("section title has no colon")}
+			s.done = true
+			return false
+		}
+		section = Section{
+			Title:  strings.TrimSpace(title),
+			Values: strings.TrimSpace(values),
+			Lines:  lines[1:],
+		}
+	}
+
+	if empty > 0 {
+		s.pending, s.next = empty-1, &section
+		s.section = Section{}
+		return true
+	}
+	s.section = section
+	return true
+}
+
+// Section returns the section read by the most recent call to Scan.
+func (s *SectionScanner) Section() Section {
+	return s.section
+}
+
+// Err returns the first error that was encountered by the SectionScanner.
+func (s *SectionScanner) Err() error {
+	return s.err
+}
+
+// ReadSections reads all sections from r.
+func ReadSections(r io.Reader, opts SectionOptions) ([]Section, error) {
+	var sections []Section
+	s := NewSectionScanner(r, opts)
+	for s.Scan() {
+		sections = append(sections, s.Section())
+	}
+	return sections, s.Err()
+}
+
+// Sections reads all blank-line-separated sections from r and returns their
+// lines. Additional blank lines are skipped.
+func Sections(r io.Reader) ([][]string, error) {
+	sections, err := ReadSections(r, SectionOptions{})
+	if err != nil {
+		return nil, err
+	}
+	result := make([][]string, len(sections))
+	for i, s := range sections {
+		result[i] = s.Lines
+	}
+	return result, nil
+}
@@ -0,0 +1,81 @@
+package parse
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const almanac = `seeds: 79 14 55 13
+
+seed-to-soil map:
+50 98 2
+52 50 48
+
+
+soil-to-fertilizer map:
+0 15 37
+  
+`
+
+func TestSections(t *testing.T) {
+	got, err := Sections(strings.NewReader(almanac))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := [][]string{
+		{"seeds: 79 14 55 13"},
+		{"seed-to-soil map:", "50 98 2", "52 50 48"},
+		{"soil-to-fertilizer map:", "0 15 37", "  "},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Sections() = %q, want %q", got, want)
+	}
+}
+
+func TestReadSections(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    SectionOptions
+		want    []Section
+		wantErr bool
+	}{
+		{"titled", SectionOptions{Titled: true, TrimSpace: true}, []Section{
+			{Title: "seeds", Values: "79 14 55 13", Lines: []string{}},
+			{Title: "seed-to-soil map", Lines: []string{"50 98 2", "52 50 48"}},
+			{Title: "soil-to-fertilizer map", Lines: []string{"0 15 37"}},
+		}, false},
+		{"keep empty", SectionOptions{KeepEmpty: true, TrimSpace: true}, []Section{
+			{Lines: []string{"seeds: 79 14 55 13"}},
+			{Lines: []string{"seed-to-soil map:", "50 98 2", "52 50 48"}},
+			{},
+			{Lines: []string{"soil-to-fertilizer map:", "0 15 37"}},
+		}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ReadSections(strings.NewReader(almanac), tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ReadSections() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ReadSections() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	for _, input := range []string{"a\n\n\n", "a\n\n\n\n", "\n\na\n\n\n\n\n"} {
+		got, err := ReadSections(strings.NewReader(input), SectionOptions{KeepEmpty: true})
+		want := []Section{{Lines: []string{"a"}}}
+		if strings.HasPrefix(input, "\n") {
+			want = []Section{{}, {}, {Lines: []string{"a"}}}
+		}
+		if err != nil || !reflect.DeepEqual(got, want) {
+			t.Errorf("ReadSections(%q) = %q, %v, want %q", input, got, err, want)
+		}
+	}
+
+	if _, err := ReadSections(strings.NewReader("a: 1\n\nb\n"), SectionOptions{Titled: true}); err == nil {
+		t.Error("expected error for section without title")
+	}
+}
@@ -0,0 +1,154 @@
+package parse
+
+import (
+	"slices"
+)
+
+// A Token is a match found by a Tokenizer.
+type Token struct {
+	// Pos is the byte offset of the match.
+	Pos   int
+	Word  string
+	Value int
+}
+
+// DigitWords are the English names of the digits one to nine.
+var DigitWords = map[string]int{
+	"one":   1,
+	"two":   2,
+	"three": 3,
+	"four":  4,
+	"five":  5,
+	"six":   6,
+	"seven": 7,
+	"eight": 8,
+	"nine":  9,
+}
+
+// Digits are the decimal digits.
+var Digits = map[string]int{
+	"0": 0, "1": 1, "2": 2, "3": 3, "4": 4,
+	"5": 5, "6": 6, "7": 7, "8": 8, "9": 9,
+}
+
+// A Tokenizer finds all occurrences of a set of words in a string, including
+// overlapping ones, in a single pass using the Aho-Corasick algorithm.
+type Tokenizer struct {
+	nodes []tokenizerNode
+}
+
+type tokenizerNode struct {
+	next map[byte]int
+	// fail is the node of the longest proper suffix that is also in the trie.
+	fail int
+	// outputs are the words ending at this node, including those reachable
+	// through fail.
+	outputs []Token
+}
+
+// NewTokenizer creates a Tokenizer that finds the words of all tables,
+// mapping each of them to its value. If a word occurs in several tables,
+// the value of the last one is used.
+func NewTokenizer(tables ...map[string]int) *Tokenizer {
+	words := make(map[string]int)
+	for _, table := range tables {
+		for w, v := range table {
+			words[w] = v
+		}
+	}
+
+	t := &Tokenizer{nodes: []tokenizerNode{{next: make(map[byte]int)}}}
+
+	// Insert in sorted order to make the automaton deterministic.
+	keys := make([]string, 0, len(words))
+	for w := range words {
+		keys = append(keys, w)
+	}
+	slices.Sort(keys)
+
+	for _, w := range keys {
+		if w == "" {
+			continue
+		}
+		n := 0
+		for i := 0; i < len(w); i++ {
+			child, ok := t.nodes[n].next[w[i]]
+			if !ok {
+				child = len(t.nodes)
+				t.nodes = append(t.nodes, tokenizerNode{next: make(map[byte]int)})
+				t.nodes[n].next[w[i]] = child
+			}
+			n = child
+		}
+		t.nodes[n].outputs = append(t.nodes[n].outputs, Token{Word: w, Value: words[w]})
+	}
+
+	// Compute failure links breadth-first.
+	queue := make([]int, 0, len(t.nodes))
+	for _, b := range sortedBytes(t.nodes[0].next) {
+		queue = append(queue, t.nodes[0].next[b])
+	}
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+		for _, b := range sortedBytes(t.nodes[n].next) {
+			child := t.nodes[n].next[b]
+			f := t.nodes[n].fail
+			for {
+				if next, ok := t.nodes[f].next[b]; ok {
+					t.nodes[child].fail = next
+					break
+				}
+				if f == 0 {
+					break
+				}
+				f = t.nodes[f].fail
+			}
+			t.nodes[child].outputs = append(t.nodes[child].outputs, t.nodes[t.nodes[child].fail].outputs...)
+			queue = append(queue, child)
+		}
+	}
+
+	return t
+}
+
+func sortedBytes(m map[byte]int) []byte {
+	keys := make([]byte, 0, len(m))
+	for b := range m {
+		keys = append(keys, b)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// FindAll returns all, possibly overlapping, occurrences of the tokenizer's
+// words in s, ordered by position. Matches at the same position are ordered
+// from longest to shortest.
+func (t *Tokenizer) FindAll(s string) []Token {
+	var result []Token
+	n := 0
+	for i := 0; i < len(s); i++ {
+		for {
+			if next, ok := t.nodes[n].next[s[i]]; ok {
+				n = next
+				break
+			}
+			if n == 0 {
+				break
+			}
+			n = t.nodes[n].fail
+		}
+		for _, out := range t.nodes[n].outputs {
+			out.Pos = i + 1 - len(out.Word)
+			result = append(result, out)
+		}
+	}
+
+	slices.SortStableFunc(result, func(a, b Token) int {
+		if a.Pos != b.Pos {
+			return a.Pos - b.Pos
+		}
+		return len(b.Word) - len(a.Word)
+	})
+	return result
+}
@@ -0,0 +1,32 @@
+package parse
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTokenizer_FindAll(t *testing.T) {
+	english := NewTokenizer(Digits, DigitWords)
+	german := NewTokenizer(map[string]int{"eins": 1, "zwei": 2, "drei": 3, "sieben": 7, "acht": 8, "neun": 9})
+	overlap := NewTokenizer(map[string]int{"he": 1, "she": 2, "his": 3, "hers": 4})
+
+	tests := []struct {
+		name string
+		t    *Tokenizer
+		s    string
+		want []Token
+	}{
+		{"eightwo", english, "eightwo", []Token{{0, "eight", 8}, {4, "two", 2}}},
+		{"mixed", english, "xtwone3four", []Token{{1, "two", 2}, {3, "one", 1}, {6, "3", 3}, {7, "four", 4}}},
+		{"nothing", english, "abc", nil},
+		{"german", german, "dreinsiebenacht", []Token{{0, "drei", 3}, {2, "eins", 1}, {5, "sieben", 7}, {11, "acht", 8}}},
+		{"suffixes", overlap, "ushers", []Token{{1, "she", 2}, {2, "hers", 4}, {2, "he", 1}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.t.FindAll(tt.s); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Tokenizer.FindAll() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
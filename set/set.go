@@ -0,0 +1,108 @@
+// Package set provides a generic set type.
+package set
+
+import (
+	"cmp"
+	"slices"
+)
+
+// A Set is a set of Ts. As it is a map, len, range and delete work as usual.
+// The zero value is an empty set that can be read, but not added to.
+type Set[T comparable] map[T]struct{}
+
+// Of returns a new set containing the given elements.
+func Of[T comparable](elems ...T) Set[T] {
+	s := make(Set[T], len(elems))
+	for _, e := range elems {
+		s.Add(e)
+	}
+	return s
+}
+
+// Add adds e to s.
+func (s Set[T]) Add(e T) {
+	s[e] = struct{}{}
+}
+
+// Has returns true if e is in s.
+func (s Set[T]) Has(e T) bool {
+	_, ok := s[e]
+	return ok
+}
+
+// Len returns the number of elements in s.
+func (s Set[T]) Len() int {
+	return len(s)
+}
+
+// Foreach calls f exactly once for each element in s, in no particular order.
+func (s Set[T]) Foreach(f func(e T)) {
+	for e := range s {
+		f(e)
+	}
+}
+
+// Slice returns the elements of s in no particular order.
+func (s Set[T]) Slice() []T {
+	result := make([]T, 0, len(s))
+	for e := range s {
+		result = append(result, e)
+	}
+	return result
+}
+
+// Sorted returns the elements of s in ascending order.
+func Sorted[T cmp.Ordered](s Set[T]) []T {
+	result := s.Slice()
+	slices.Sort(result)
+	return result
+}
+
+// Intersect returns a new set of the elements in both s and o.
+func (s Set[T]) Intersect(o Set[T]) Set[T] {
+	if len(o) < len(s) {
+		s, o = o, s
+	}
+	result := make(Set[T])
+	for e := range s {
+		if o.Has(e) {
+			result.Add(e)
+		}
+	}
+	return result
+}
+
+// Union returns a new set of the elements in s or o.
+func (s Set[T]) Union(o Set[T]) Set[T] {
+	result := make(Set[T], len(s)+len(o))
+	for e := range s {
+		result.Add(e)
+	}
+	for e := range o {
+		result.Add(e)
+	}
+	return result
+}
+
+// Difference returns a new set of the elements in s, but not in o.
+func (s Set[T]) Difference(o Set[T]) Set[T] {
+	result := make(Set[T])
+	for e := range s {
+		if !o.Has(e) {
+			result.Add(e)
+		}
+	}
+	return result
+}
+
+// SymmetricDifference returns a new set of the elements in exactly one of
+// s and o.
+func (s Set[T]) SymmetricDifference(o Set[T]) Set[T] {
+	result := s.Difference(o)
+	for e := range o {
+		if !s.Has(e) {
+			result.Add(e)
+		}
+	}
+	return result
+}
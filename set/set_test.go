@@ -0,0 +1,43 @@
+package set
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSet(t *testing.T) {
+	a := Of(41, 48, 83, 86, 17)
+	b := Of(83, 86, 6, 31, 17, 9, 48, 53)
+
+	tests := []struct {
+		name string
+		got  Set[int]
+		want []int
+	}{
+		{"Intersect", a.Intersect(b), []int{17, 48, 83, 86}},
+		{"Union", a.Union(b), []int{6, 9, 17, 31, 41, 48, 53, 83, 86}},
+		{"Difference", a.Difference(b), []int{41}},
+		{"SymmetricDifference", a.SymmetricDifference(b), []int{6, 9, 31, 41, 53}},
+		{"empty", Set[int](nil).Intersect(a), []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Sorted(tt.got); !slices.Equal(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if !a.Has(41) || a.Has(6) {
+		t.Error("Has is wrong")
+	}
+	if a.Len() != 5 || len(a.Intersect(b)) != 4 {
+		t.Error("Len is wrong")
+	}
+
+	sum := 0
+	a.Foreach(func(e int) { sum += e })
+	if sum != 275 {
+		t.Errorf("sum over Foreach = %d, want 275", sum)
+	}
+}